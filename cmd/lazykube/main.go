@@ -1,25 +1,97 @@
 package main
 
 import (
+	"bufio"
+	"flag"
+	"fmt"
 	"lazykube/internal/infrastructure/config"
 	"lazykube/internal/infrastructure/datastore"
+	"lazykube/internal/infrastructure/logging"
 	"lazykube/internal/infrastructure/tui"
 	"lazykube/internal/registry"
+	"log/slog"
 	"maps"
+	"os"
+	"strings"
 )
 
 func main() {
+	forward := flag.String("forward", "", "start the port-forwards of the config profile on launch")
+	debug := flag.Bool("debug", false, "write debug logs, with the timing of the api requests, to the log file")
+	logFile := flag.String("log-file", "", "write the logs to the file")
+	readOnly := flag.Bool("readonly", false, "disable every action that changes a cluster or runs code in it")
+	yes := flag.Bool("yes", false, "start the port-forwards of --forward on protected contexts without asking")
+	flag.Parse()
+
+	level := slog.LevelInfo
+	if *debug {
+		level = slog.LevelDebug
+		if *logFile == "" {
+			*logFile = logging.DefaultLogFile()
+		}
+	}
+	closeLog, err := logging.Setup(*logFile, level)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer closeLog()
+
 	conf, err := config.ReadConfig()
 	if err != nil {
-		panic(1)
+		fmt.Fprintf(os.Stderr, "reading the config: %v\n", err)
+		os.Exit(1)
+	}
+	if *readOnly {
+		conf.ReadOnly = true
 	}
 	clients, configs, err := datastore.NewKubeConnections()
 	if err != nil {
-		panic(1)
+		fmt.Fprintf(os.Stderr, "connecting to the clusters: %v\n", err)
+		os.Exit(1)
 	}
 
-	registry := registry.NewRegistry(clients, configs)
+	auditFile := conf.AuditFile
+	if auditFile == "" {
+		auditFile = datastore.DefaultAuditFile()
+	}
+	registry := registry.NewRegistry(clients, configs, auditFile)
 	controllers := registry.NewAppController()
+	if *forward != "" {
+		profile, ok := conf.Profile(*forward)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "port-forward profile not found: %s\n", *forward)
+			os.Exit(1)
+		}
+		contexts := []string{}
+		for _, entry := range profile.Forwards {
+			contexts = append(contexts, entry.Context)
+		}
+		switch protection, matched := conf.StrictestProtection(contexts); protection {
+		case config.ProtectionDenied:
+			fmt.Fprintf(os.Stderr, "read-only mode: port-forward is disabled on %s\n", strings.Join(matched, ", "))
+			os.Exit(1)
+		case config.ProtectionConfirm:
+			if !*yes && !confirm(fmt.Sprintf("Protected contexts: %s. Start the port-forwards of profile %s?", strings.Join(matched, ", "), profile.Name)) {
+				fmt.Fprintln(os.Stderr, "port-forward of protected contexts not confirmed, use --yes to start it")
+				os.Exit(1)
+			}
+		}
+		for _, entry := range profile.Forwards {
+			if err := tui.StartPortForward(&controllers, entry.Target(), entry.Ports, entry.Reconnect); err != nil {
+				fmt.Fprintf(os.Stderr, "port-forward of profile %s failed: %v\n", profile.Name, err)
+				os.Exit(1)
+			}
+		}
+	}
 	clusters := maps.Keys(clients)
 	tui.NewApp(clusters, controllers, conf)
 }
+
+// confirm asks the question on the terminal and reports if the user answered yes
+func confirm(question string) bool {
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type accessController struct {
+	AccessInteractor usecase.AccessInteractor
+}
+
+// NewAccessController return a controller
+func NewAccessController(interactor usecase.AccessInteractor) AccessController {
+	return &accessController{
+		AccessInteractor: interactor,
+	}
+}
+
+func (aC *accessController) Review(ctx context.Context, clusterContext, namespace, group, resource string, permissions ...domain.Permission) (domain.Access, error) {
+	return aC.AccessInteractor.Review(ctx, clusterContext, namespace, group, resource, permissions...)
+}
@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"bytes"
 	"context"
 	"io"
 	"lazykube/internal/domain"
@@ -11,22 +10,140 @@ import (
 
 // AppController Init for controller
 type AppController struct {
-	Pod        interface{ ControllerResource }
-	Deployment interface{ ControllerResource }
-	Namespace  interface{ NamespaceController }
+	Pod         interface{ ControllerResource }
+	Deployment  interface{ ControllerResource }
+	Service     interface{ ServiceController }
+	Secret      interface{ SecretController }
+	ConfigMap   interface{ ConfigMapController }
+	Job         interface{ JobController }
+	CronJob     interface{ CronJobController }
+	StatefulSet interface{ StatefulSetController }
+	DaemonSet   interface{ DaemonSetController }
+	Ingress     interface{ IngressController }
+	HTTPRoute   interface{ IngressController }
+	PVC         interface{ ResourceLister }
+	PV          interface{ ResourceLister }
+	HPA         interface{ AutoscalerController }
+	Namespace   interface{ NamespaceController }
+	PortForward interface{ PortForwardController }
+	Health      interface{ HealthController }
+	Access      interface{ AccessController }
+	Audit       interface{ AuditController }
+}
+
+// ResourceLister lists a resource type and shows its yaml. A listing returns
+// the results of the contexts that succeeded with a *domain.MultiContextError
+// for the ones that failed.
+type ResourceLister interface {
+	GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error)
+	GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error)
 }
 
 type ControllerResource interface {
+	ResourceLister
 	GetAll(ctx context.Context, namespace string) (map[string][]map[string]string, error)
 	GetAllOneContext(ctx context.Context, namespace string, context string) ([]map[string]string, error)
-	GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error)
-	GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error)
-	Exec(ctx context.Context, podName, namespace, context, command, containerName string, dryRun bool, options remotecommand.StreamOptions) error
+	GetPod(ctx context.Context, podName, namespace, context string) (*domain.Pod, error)
+	Exec(ctx context.Context, podName, namespace, context string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error
+	ExecShell(ctx context.Context, podName, namespace, context string, shells []string, containerName string, options remotecommand.StreamOptions, started func(shell string)) (string, error)
 	GetLogs(ctx context.Context, resourceName, namespace, context, containerName string) (io.ReadCloser, error)
-	PortForward(ctx context.Context, resourceName, namespace, context string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error)
+	Debug(ctx context.Context, podName, namespace, context, image, targetContainer string) (string, error)
+	CopyFrom(ctx context.Context, podName, namespace, context, containerName, remotePath, localPath string, progress func(int64)) error
+	CopyTo(ctx context.Context, podName, namespace, context, containerName, localPath, remotePath string, progress func(int64)) error
 	GetPods(ctx context.Context, resourceName, namespace, context string) ([]domain.Pod, error)
 }
 
+// ServiceController lists the services and the backends behind them
+type ServiceController interface {
+	ResourceLister
+	GetEndpoints(ctx context.Context, serviceName, namespace, context string) ([]domain.ServiceEndpoint, error)
+}
+
+// IngressController lists the ingresses or the httproutes and resolves their
+// routes to the services and their certificates
+type IngressController interface {
+	ResourceLister
+	GetRouting(ctx context.Context, ingressName, namespace, context string) (*domain.IngressRouting, error)
+}
+
+// AutoscalerController lists the horizontalpodautoscalers, finds the one of a
+// workload and edits the bounds of their replicas
+type AutoscalerController interface {
+	ResourceLister
+	GetAutoscaler(ctx context.Context, autoscalerName, namespace, context string) (*domain.HorizontalPodAutoscaler, error)
+	GetForTarget(ctx context.Context, kind, name, namespace, context string) (*domain.HorizontalPodAutoscaler, error)
+	UpdateReplicas(ctx context.Context, autoscalerName, namespace, context string, minReplicas, maxReplicas int32) error
+}
+
+// SecretController lists the secrets with their values masked, the values
+// are only returned decoded on demand
+type SecretController interface {
+	ResourceLister
+	GetSecret(ctx context.Context, secretName, namespace, context string) (*domain.Secret, error)
+	GetDecoded(ctx context.Context, secretName, namespace, context string) ([]byte, error)
+	GetValue(ctx context.Context, secretName, namespace, context, key string) ([]byte, error)
+}
+
+// ConfigMapController lists the configmaps and edits their values one key at a time
+type ConfigMapController interface {
+	ResourceLister
+	GetKeys(ctx context.Context, configMapName, namespace, context string) ([]domain.ConfigMapKey, error)
+	GetValue(ctx context.Context, configMapName, namespace, context, key string) (string, error)
+	UpdateValue(ctx context.Context, configMapName, namespace, context, key, original, value string) error
+	GetReferences(ctx context.Context, configMapName, namespace, context string) ([]domain.ConfigMapReference, error)
+}
+
+type JobController interface {
+	ResourceLister
+	PodOwner
+}
+
+type CronJobController interface {
+	ResourceLister
+	SetSuspend(ctx context.Context, cronJobName, namespace, context string, suspend bool) error
+	Trigger(ctx context.Context, cronJobName, namespace, context string) (string, error)
+}
+
+// PodOwner is a workload that finds its pods by owner references
+type PodOwner interface {
+	GetPods(ctx context.Context, resourceName, namespace, context string) ([]domain.Pod, error)
+}
+
+type StatefulSetController interface {
+	ResourceLister
+	PodOwner
+	Restart(ctx context.Context, statefulSetName, namespace, context string) error
+	Scale(ctx context.Context, statefulSetName, namespace, context string, replicas int32) error
+}
+
+type DaemonSetController interface {
+	ResourceLister
+	PodOwner
+	Restart(ctx context.Context, daemonSetName, namespace, context string) error
+}
+
+type PortForwardController interface {
+	Start(target domain.PortForwardTarget, ports []string, reconnect bool, failed func(err error)) (int, error)
+	Stop(id int) error
+	Remove(id int) error
+	Prune() int
+	List() []domain.PortForward
+}
+
+type AuditController interface {
+	Record(entry domain.AuditEntry, err error)
+	List(filter string) ([]domain.AuditEntry, error)
+}
+
+type AccessController interface {
+	Review(ctx context.Context, clusterContext, namespace, group, resource string, permissions ...domain.Permission) (domain.Access, error)
+}
+
+type HealthController interface {
+	CheckAll(ctx context.Context) []domain.ClusterHealth
+}
+
 type NamespaceController interface {
 	GetAll(ctx context.Context, clusterContext string) ([]string, error)
+	GetUnion(ctx context.Context, contexts []string) ([]domain.Namespace, error)
 }
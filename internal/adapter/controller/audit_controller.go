@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type auditController struct {
+	AuditInteractor usecase.AuditInteractor
+}
+
+// NewAuditController return a controller
+func NewAuditController(interactor usecase.AuditInteractor) AuditController {
+	return &auditController{
+		AuditInteractor: interactor,
+	}
+}
+
+func (aC *auditController) Record(entry domain.AuditEntry, err error) {
+	aC.AuditInteractor.Record(entry, err)
+}
+
+func (aC *auditController) List(filter string) ([]domain.AuditEntry, error) {
+	return aC.AuditInteractor.List(filter)
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type configMapController struct {
+	ConfigMapInteractor usecase.ConfigMapInteractor
+}
+
+// NewConfigMapController return a controller
+func NewConfigMapController(interactor usecase.ConfigMapInteractor) ConfigMapController {
+	return &configMapController{
+		ConfigMapInteractor: interactor,
+	}
+}
+
+func (cC *configMapController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	configMapLists, err := cC.ConfigMapInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return ConfigMapListsToMaps(configMapLists), err
+}
+
+func (cC *configMapController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return cC.ConfigMapInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (cC *configMapController) GetKeys(ctx context.Context, configMapName, namespace, context string) ([]domain.ConfigMapKey, error) {
+	return cC.ConfigMapInteractor.GetKeys(ctx, configMapName, namespace, context)
+}
+
+func (cC *configMapController) GetValue(ctx context.Context, configMapName, namespace, context, key string) (string, error) {
+	return cC.ConfigMapInteractor.GetValue(ctx, configMapName, namespace, context, key)
+}
+
+func (cC *configMapController) UpdateValue(ctx context.Context, configMapName, namespace, context, key, original, value string) error {
+	return cC.ConfigMapInteractor.UpdateValue(ctx, configMapName, namespace, context, key, original, value)
+}
+
+func (cC *configMapController) GetReferences(ctx context.Context, configMapName, namespace, context string) ([]domain.ConfigMapReference, error) {
+	return cC.ConfigMapInteractor.GetReferences(ctx, configMapName, namespace, context)
+}
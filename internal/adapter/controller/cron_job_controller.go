@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/usecase"
+)
+
+type cronJobController struct {
+	CronJobInteractor usecase.CronJobInteractor
+}
+
+// NewCronJobController return a controller
+func NewCronJobController(interactor usecase.CronJobInteractor) CronJobController {
+	return &cronJobController{
+		CronJobInteractor: interactor,
+	}
+}
+
+func (cC *cronJobController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	cronJobLists, err := cC.CronJobInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return CronJobListsToMaps(cronJobLists), err
+}
+
+func (cC *cronJobController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return cC.CronJobInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (cC *cronJobController) SetSuspend(ctx context.Context, cronJobName, namespace, context string, suspend bool) error {
+	return cC.CronJobInteractor.SetSuspend(ctx, cronJobName, namespace, context, suspend)
+}
+
+func (cC *cronJobController) Trigger(ctx context.Context, cronJobName, namespace, context string) (string, error) {
+	return cC.CronJobInteractor.Trigger(ctx, cronJobName, namespace, context)
+}
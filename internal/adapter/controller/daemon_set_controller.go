@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type daemonSetController struct {
+	DaemonSetInteractor usecase.DaemonSetInteractor
+}
+
+// NewDaemonSetController return a controller
+func NewDaemonSetController(interactor usecase.DaemonSetInteractor) DaemonSetController {
+	return &daemonSetController{
+		DaemonSetInteractor: interactor,
+	}
+}
+
+func (dC *daemonSetController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	daemonSetLists, err := dC.DaemonSetInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return DaemonSetListsToMaps(daemonSetLists), err
+}
+
+func (dC *daemonSetController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return dC.DaemonSetInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (dC *daemonSetController) GetPods(ctx context.Context, daemonSetName, namespace, context string) ([]domain.Pod, error) {
+	return dC.DaemonSetInteractor.GetPods(ctx, daemonSetName, namespace, context)
+}
+
+func (dC *daemonSetController) Restart(ctx context.Context, daemonSetName, namespace, context string) error {
+	return dC.DaemonSetInteractor.Restart(ctx, daemonSetName, namespace, context)
+}
@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"io"
@@ -24,33 +23,43 @@ func NewDeploymentController(dinteractor usecase.DeploymentInteractor, pinteract
 	}
 }
 
-func (dC *deploymentController) PortForward(ctx context.Context, resourceName, namespace, context string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error) {
-	return nil, nil, errors.New("port-forward not directly supported for deployments, use GetPods to select a pod first")
-}
-
 func (dC *deploymentController) GetPods(ctx context.Context, deploymentName, namespace, context string) ([]domain.Pod, error) {
 	return dC.DeploymentInteractor.GetPods(ctx, deploymentName, namespace, context)
 }
 
-func (dC *deploymentController) Exec(ctx context.Context, deploymentName, namespace, context, command, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
+func (dC *deploymentController) CopyFrom(ctx context.Context, deploymentName, namespace, context, containerName, remotePath, localPath string, progress func(int64)) error {
+	return errors.New("copy not directly supported for deployments, use GetPods to select a pod first")
+}
+
+func (dC *deploymentController) CopyTo(ctx context.Context, deploymentName, namespace, context, containerName, localPath, remotePath string, progress func(int64)) error {
+	return errors.New("copy not directly supported for deployments, use GetPods to select a pod first")
+}
+
+func (dC *deploymentController) Debug(ctx context.Context, deploymentName, namespace, context, image, targetContainer string) (string, error) {
+	return "", errors.New("debug not directly supported for deployments, use GetPods to select a pod first")
+}
+
+func (dC *deploymentController) GetPod(ctx context.Context, podName, namespace, context string) (*domain.Pod, error) {
+	return nil, errors.New("pod lookup not directly supported for deployments, use GetPods to select a pod first")
+}
+
+func (dC *deploymentController) Exec(ctx context.Context, deploymentName, namespace, context string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
 	return errors.New("exec not directly supported for deployments, use GetPods to select a pod first")
 }
 
+func (dC *deploymentController) ExecShell(ctx context.Context, deploymentName, namespace, context string, shells []string, containerName string, options remotecommand.StreamOptions, started func(shell string)) (string, error) {
+	return "", errors.New("exec not directly supported for deployments, use GetPods to select a pod first")
+}
+
 func (dC *deploymentController) GetAll(ctx context.Context, namespace string) (map[string][]map[string]string, error) {
+	// The lists of the contexts that succeeded are kept with the error
 	deploymentLists, err := dC.DeploymentInteractor.GetAll(ctx, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	return DeploymentListsToMaps(deploymentLists), nil
+	return DeploymentListsToMaps(deploymentLists), err
 }
 
 func (dC *deploymentController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
 	deployments, err := dC.DeploymentInteractor.GetFromManyContext(ctx, namespaces, contexts)
-	if err != nil {
-		return nil, err
-	}
-	return DeploymentListsToMaps(deployments), nil
+	return DeploymentListsToMaps(deployments), err
 }
 
 func (dC *deploymentController) GetAllOneContext(ctx context.Context, namespace string, context string) ([]map[string]string, error) {
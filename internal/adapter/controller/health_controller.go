@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type healthController struct {
+	HealthInteractor usecase.HealthInteractor
+}
+
+// NewHealthController return a controller
+func NewHealthController(interactor usecase.HealthInteractor) HealthController {
+	return &healthController{
+		HealthInteractor: interactor,
+	}
+}
+
+func (hC *healthController) CheckAll(ctx context.Context) []domain.ClusterHealth {
+	return hC.HealthInteractor.CheckAll(ctx)
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type horizontalPodAutoscalerController struct {
+	HorizontalPodAutoscalerInteractor usecase.HorizontalPodAutoscalerInteractor
+}
+
+// NewHorizontalPodAutoscalerController return a controller
+func NewHorizontalPodAutoscalerController(interactor usecase.HorizontalPodAutoscalerInteractor) AutoscalerController {
+	return &horizontalPodAutoscalerController{
+		HorizontalPodAutoscalerInteractor: interactor,
+	}
+}
+
+func (hC *horizontalPodAutoscalerController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	autoscalerLists, err := hC.HorizontalPodAutoscalerInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return HorizontalPodAutoscalerListsToMaps(autoscalerLists), err
+}
+
+func (hC *horizontalPodAutoscalerController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return hC.HorizontalPodAutoscalerInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (hC *horizontalPodAutoscalerController) GetAutoscaler(ctx context.Context, autoscalerName, namespace, context string) (*domain.HorizontalPodAutoscaler, error) {
+	return hC.HorizontalPodAutoscalerInteractor.GetAutoscaler(ctx, autoscalerName, namespace, context)
+}
+
+func (hC *horizontalPodAutoscalerController) GetForTarget(ctx context.Context, kind, name, namespace, context string) (*domain.HorizontalPodAutoscaler, error) {
+	return hC.HorizontalPodAutoscalerInteractor.GetForTarget(ctx, kind, name, namespace, context)
+}
+
+func (hC *horizontalPodAutoscalerController) UpdateReplicas(ctx context.Context, autoscalerName, namespace, context string, minReplicas, maxReplicas int32) error {
+	return hC.HorizontalPodAutoscalerInteractor.UpdateReplicas(ctx, autoscalerName, namespace, context, minReplicas, maxReplicas)
+}
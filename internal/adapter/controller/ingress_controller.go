@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type ingressController struct {
+	IngressInteractor usecase.IngressInteractor
+}
+
+// NewIngressController return a controller, for the ingresses or the httproutes
+func NewIngressController(interactor usecase.IngressInteractor) IngressController {
+	return &ingressController{
+		IngressInteractor: interactor,
+	}
+}
+
+func (iC *ingressController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	ingressLists, err := iC.IngressInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return IngressListsToMaps(ingressLists), err
+}
+
+func (iC *ingressController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return iC.IngressInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (iC *ingressController) GetRouting(ctx context.Context, ingressName, namespace, context string) (*domain.IngressRouting, error) {
+	return iC.IngressInteractor.GetRouting(ctx, ingressName, namespace, context)
+}
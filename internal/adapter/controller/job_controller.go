@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type jobController struct {
+	JobInteractor usecase.JobInteractor
+}
+
+// NewJobController return a controller
+func NewJobController(interactor usecase.JobInteractor) JobController {
+	return &jobController{
+		JobInteractor: interactor,
+	}
+}
+
+func (jC *jobController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	jobLists, err := jC.JobInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return JobListsToMaps(jobLists), err
+}
+
+func (jC *jobController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return jC.JobInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (jC *jobController) GetPods(ctx context.Context, jobName, namespace, context string) ([]domain.Pod, error) {
+	return jC.JobInteractor.GetPods(ctx, jobName, namespace, context)
+}
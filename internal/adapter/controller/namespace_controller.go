@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"lazykube/internal/domain"
 	"lazykube/internal/usecase"
 )
 
@@ -18,3 +19,7 @@ func NewNamespaceController(interactor usecase.NamespaceInteractor) NamespaceCon
 func (nC namespaceController) GetAll(ctx context.Context, clusterContext string) ([]string, error) {
 	return nC.NamespaceInteractor.GetAll(ctx, clusterContext)
 }
+
+func (nC namespaceController) GetUnion(ctx context.Context, contexts []string) ([]domain.Namespace, error) {
+	return nC.NamespaceInteractor.GetUnion(ctx, contexts)
+}
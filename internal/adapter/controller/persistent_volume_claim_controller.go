@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/usecase"
+)
+
+type persistentVolumeClaimController struct {
+	PersistentVolumeClaimInteractor usecase.PersistentVolumeClaimInteractor
+}
+
+// NewPersistentVolumeClaimController return a controller
+func NewPersistentVolumeClaimController(interactor usecase.PersistentVolumeClaimInteractor) ResourceLister {
+	return &persistentVolumeClaimController{
+		PersistentVolumeClaimInteractor: interactor,
+	}
+}
+
+func (pC *persistentVolumeClaimController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	claimLists, err := pC.PersistentVolumeClaimInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return PersistentVolumeClaimListsToMaps(claimLists), err
+}
+
+func (pC *persistentVolumeClaimController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return pC.PersistentVolumeClaimInteractor.GetYaml(ctx, namespace, name, context)
+}
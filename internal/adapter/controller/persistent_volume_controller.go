@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/usecase"
+)
+
+type persistentVolumeController struct {
+	PersistentVolumeInteractor usecase.PersistentVolumeInteractor
+}
+
+// NewPersistentVolumeController return a controller
+func NewPersistentVolumeController(interactor usecase.PersistentVolumeInteractor) ResourceLister {
+	return &persistentVolumeController{
+		PersistentVolumeInteractor: interactor,
+	}
+}
+
+func (pC *persistentVolumeController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	volumeLists, err := pC.PersistentVolumeInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return PersistentVolumeListsToMaps(volumeLists), err
+}
+
+func (pC *persistentVolumeController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return pC.PersistentVolumeInteractor.GetYaml(ctx, namespace, name, context)
+}
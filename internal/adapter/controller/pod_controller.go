@@ -1,7 +1,6 @@
 package controller
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -24,17 +23,34 @@ func NewPodController(interactor usecase.PodInteractor) ControllerResource {
 	}
 }
 
-func (pC *podController) Exec(ctx context.Context, podName, namespace, context, command, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
+func (pC *podController) CopyFrom(ctx context.Context, podName, namespace, context, containerName, remotePath, localPath string, progress func(int64)) error {
+	return pC.Interactor.CopyFrom(ctx, podName, namespace, context, containerName, remotePath, localPath, progress)
+}
+
+func (pC *podController) CopyTo(ctx context.Context, podName, namespace, context, containerName, localPath, remotePath string, progress func(int64)) error {
+	return pC.Interactor.CopyTo(ctx, podName, namespace, context, containerName, localPath, remotePath, progress)
+}
+
+func (pC *podController) Debug(ctx context.Context, podName, namespace, context, image, targetContainer string) (string, error) {
+	return pC.Interactor.Debug(ctx, podName, namespace, context, image, targetContainer)
+}
+
+func (pC *podController) GetPod(ctx context.Context, podName, namespace, context string) (*domain.Pod, error) {
+	return pC.Interactor.GetPod(ctx, podName, namespace, context)
+}
+
+func (pC *podController) Exec(ctx context.Context, podName, namespace, context string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
 	return pC.Interactor.Exec(ctx, podName, namespace, context, command, containerName, dryRun, options)
 }
 
+func (pC *podController) ExecShell(ctx context.Context, podName, namespace, context string, shells []string, containerName string, options remotecommand.StreamOptions, started func(shell string)) (string, error) {
+	return pC.Interactor.ExecShell(ctx, podName, namespace, context, shells, containerName, options, started)
+}
+
 func (pC *podController) GetAll(ctx context.Context, namespace string) (map[string][]map[string]string, error) {
+	// The lists of the contexts that succeeded are kept with the error
 	podLists, err := pC.Interactor.GetAll(ctx, namespace)
-	if err != nil {
-		return nil, err
-	}
-
-	return PodListsToMaps(podLists), nil
+	return PodListsToMaps(podLists), err
 }
 
 func (pC *podController) GetAllOneContext(ctx context.Context, namespace string, context string) ([]map[string]string, error) {
@@ -47,10 +63,7 @@ func (pC *podController) GetAllOneContext(ctx context.Context, namespace string,
 
 func (pC *podController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
 	pods, err := pC.Interactor.GetFromManyContext(ctx, namespaces, contexts)
-	if err != nil {
-		return nil, err
-	}
-	return PodListsToMaps(pods), nil
+	return PodListsToMaps(pods), err
 }
 
 func (pD *podController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
@@ -61,10 +74,6 @@ func (pC *podController) GetLogs(ctx context.Context, resourceName, namespace, c
 	return pC.Interactor.GetLogs(ctx, resourceName, namespace, context, containerName)
 }
 
-func (pC *podController) PortForward(ctx context.Context, resourceName, namespace, context string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error) {
-	return pC.Interactor.PortForward(ctx, resourceName, namespace, context, ports, stopChan, readyChan)
-}
-
 func (pC *podController) GetPods(ctx context.Context, resourceName, namespace, context string) ([]domain.Pod, error) {
 	return nil, nil
 }
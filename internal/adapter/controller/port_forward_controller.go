@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type portForwardController struct {
+	Interactor usecase.PortForwardInteractor
+}
+
+// NewPortForwardController return a controller for the port-forward sessions
+func NewPortForwardController(interactor usecase.PortForwardInteractor) PortForwardController {
+	return &portForwardController{
+		Interactor: interactor,
+	}
+}
+
+func (pfC *portForwardController) Start(target domain.PortForwardTarget, ports []string, reconnect bool, failed func(err error)) (int, error) {
+	return pfC.Interactor.Start(target, ports, reconnect, failed)
+}
+
+func (pfC *portForwardController) Stop(id int) error {
+	return pfC.Interactor.Stop(id)
+}
+
+func (pfC *portForwardController) Remove(id int) error {
+	return pfC.Interactor.Remove(id)
+}
+
+func (pfC *portForwardController) Prune() int {
+	return pfC.Interactor.Prune()
+}
+
+func (pfC *portForwardController) List() []domain.PortForward {
+	return pfC.Interactor.List()
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type secretController struct {
+	SecretInteractor usecase.SecretInteractor
+}
+
+// NewSecretController return a controller
+func NewSecretController(interactor usecase.SecretInteractor) SecretController {
+	return &secretController{
+		SecretInteractor: interactor,
+	}
+}
+
+func (sC *secretController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	secretLists, err := sC.SecretInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return SecretListsToMaps(secretLists), err
+}
+
+func (sC *secretController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return sC.SecretInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (sC *secretController) GetSecret(ctx context.Context, secretName, namespace, context string) (*domain.Secret, error) {
+	return sC.SecretInteractor.GetSecret(ctx, secretName, namespace, context)
+}
+
+func (sC *secretController) GetDecoded(ctx context.Context, secretName, namespace, context string) ([]byte, error) {
+	return sC.SecretInteractor.GetDecoded(ctx, secretName, namespace, context)
+}
+
+func (sC *secretController) GetValue(ctx context.Context, secretName, namespace, context, key string) ([]byte, error) {
+	return sC.SecretInteractor.GetValue(ctx, secretName, namespace, context, key)
+}
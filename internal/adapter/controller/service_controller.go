@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type serviceController struct {
+	ServiceInteractor usecase.ServiceInteractor
+}
+
+// NewServiceController return a controller
+func NewServiceController(interactor usecase.ServiceInteractor) ServiceController {
+	return &serviceController{
+		ServiceInteractor: interactor,
+	}
+}
+
+func (sC *serviceController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	serviceLists, err := sC.ServiceInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return ServiceListsToMaps(serviceLists), err
+}
+
+func (sC *serviceController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return sC.ServiceInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (sC *serviceController) GetEndpoints(ctx context.Context, serviceName, namespace, context string) ([]domain.ServiceEndpoint, error) {
+	return sC.ServiceInteractor.GetEndpoints(ctx, serviceName, namespace, context)
+}
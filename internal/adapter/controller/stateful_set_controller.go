@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+)
+
+type statefulSetController struct {
+	StatefulSetInteractor usecase.StatefulSetInteractor
+}
+
+// NewStatefulSetController return a controller
+func NewStatefulSetController(interactor usecase.StatefulSetInteractor) StatefulSetController {
+	return &statefulSetController{
+		StatefulSetInteractor: interactor,
+	}
+}
+
+func (sC *statefulSetController) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]map[string]string, error) {
+	statefulSetLists, err := sC.StatefulSetInteractor.GetFromManyContext(ctx, namespaces, contexts)
+	return StatefulSetListsToMaps(statefulSetLists), err
+}
+
+func (sC *statefulSetController) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	return sC.StatefulSetInteractor.GetYaml(ctx, namespace, name, context)
+}
+
+func (sC *statefulSetController) GetPods(ctx context.Context, statefulSetName, namespace, context string) ([]domain.Pod, error) {
+	return sC.StatefulSetInteractor.GetPods(ctx, statefulSetName, namespace, context)
+}
+
+func (sC *statefulSetController) Restart(ctx context.Context, statefulSetName, namespace, context string) error {
+	return sC.StatefulSetInteractor.Restart(ctx, statefulSetName, namespace, context)
+}
+
+func (sC *statefulSetController) Scale(ctx context.Context, statefulSetName, namespace, context string, replicas int32) error {
+	return sC.StatefulSetInteractor.Scale(ctx, statefulSetName, namespace, context, replicas)
+}
@@ -3,6 +3,10 @@ package controller
 import (
 	"fmt"
 	"lazykube/internal/domain"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func PodToMap(pod domain.Pod) map[string]string {
@@ -54,3 +58,354 @@ func DeploymentListsToMaps(deploymentLists map[string][]domain.Deployment) map[s
 	}
 	return result
 }
+
+func ServiceToMap(service domain.Service) map[string]string {
+	ports := make([]string, len(service.Ports))
+	for i, port := range service.Ports {
+		ports[i] = fmt.Sprintf("%d/%s", port.Port, port.Protocol)
+		if port.Name != "" {
+			ports[i] = port.Name + ":" + ports[i]
+		}
+	}
+	externalIPs := strings.Join(service.ExternalIPs, ",")
+	if externalIPs == "" {
+		externalIPs = "<none>"
+	}
+	return map[string]string{
+		"name":         service.Name,
+		"namespace":    service.Namespace,
+		"cluster":      service.Context,
+		"type":         service.Type,
+		"cluster_ip":   service.ClusterIP,
+		"external_ips": externalIPs,
+		"ports":        strings.Join(ports, ","),
+	}
+}
+
+func ServiceListsToMaps(serviceLists map[string][]domain.Service) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, services := range serviceLists {
+		maps := make([]map[string]string, len(services))
+		for i, service := range services {
+			maps[i] = ServiceToMap(service)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+func SecretToMap(secret domain.Secret) map[string]string {
+	keys := make([]string, len(secret.Keys))
+	for i, key := range secret.Keys {
+		keys[i] = fmt.Sprintf("%s(%dB)", key.Name, key.Size)
+	}
+	return map[string]string{
+		"name":      secret.Name,
+		"namespace": secret.Namespace,
+		"cluster":   secret.Context,
+		"type":      secret.Type,
+		"keys":      strings.Join(keys, ","),
+	}
+}
+
+func SecretListsToMaps(secretLists map[string][]domain.Secret) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, secrets := range secretLists {
+		maps := make([]map[string]string, len(secrets))
+		for i, secret := range secrets {
+			maps[i] = SecretToMap(secret)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+func ConfigMapToMap(configMap domain.ConfigMap) map[string]string {
+	keys := make([]string, 0, len(configMap.Data)+len(configMap.BinaryKeys))
+	for key := range configMap.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range configMap.BinaryKeys {
+		keys = append(keys, key+"(binary)")
+	}
+	return map[string]string{
+		"name":      configMap.Name,
+		"namespace": configMap.Namespace,
+		"cluster":   configMap.Context,
+		"keys":      strings.Join(keys, ","),
+	}
+}
+
+func ConfigMapListsToMaps(configMapLists map[string][]domain.ConfigMap) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, configMaps := range configMapLists {
+		maps := make([]map[string]string, len(configMaps))
+		for i, configMap := range configMaps {
+			maps[i] = ConfigMapToMap(configMap)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+func JobToMap(job domain.Job) map[string]string {
+	duration := ""
+	if !job.StartTime.IsZero() {
+		duration = formatDuration(job.Duration(time.Now()))
+	}
+	return map[string]string{
+		"name":        job.Name,
+		"namespace":   job.Namespace,
+		"cluster":     job.Context,
+		"completions": fmt.Sprintf("%d/%d", job.Succeeded, job.Completions),
+		"duration":    duration,
+		"status":      job.Status,
+		"cron_job":    job.CronJob,
+	}
+}
+
+func JobListsToMaps(jobLists map[string][]domain.Job) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, jobs := range jobLists {
+		maps := make([]map[string]string, len(jobs))
+		for i, job := range jobs {
+			maps[i] = JobToMap(job)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+func CronJobToMap(cronJob domain.CronJob) map[string]string {
+	now := time.Now()
+	lastRun := "never"
+	if !cronJob.LastSchedule.IsZero() {
+		lastRun = formatDuration(now.Sub(cronJob.LastSchedule)) + " ago"
+	}
+	nextRun := "-"
+	if !cronJob.NextRun.IsZero() {
+		nextRun = "in " + formatDuration(cronJob.NextRun.Sub(now))
+	}
+	schedule := cronJob.Schedule
+	if cronJob.TimeZone != "" {
+		schedule += " " + cronJob.TimeZone
+	}
+	return map[string]string{
+		"name":      cronJob.Name,
+		"namespace": cronJob.Namespace,
+		"cluster":   cronJob.Context,
+		"schedule":  schedule,
+		"suspend":   strconv.FormatBool(cronJob.Suspend),
+		"active":    strconv.Itoa(cronJob.Active),
+		"last_run":  lastRun,
+		"next_run":  nextRun,
+	}
+}
+
+func CronJobListsToMaps(cronJobLists map[string][]domain.CronJob) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, cronJobs := range cronJobLists {
+		maps := make([]map[string]string, len(cronJobs))
+		for i, cronJob := range cronJobs {
+			maps[i] = CronJobToMap(cronJob)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+func StatefulSetToMap(statefulSet domain.StatefulSet) map[string]string {
+	return map[string]string{
+		"name":      statefulSet.Name,
+		"namespace": statefulSet.Namespace,
+		"cluster":   statefulSet.Context,
+		"ready":     fmt.Sprintf("%d/%d", statefulSet.ReadyReplicas, statefulSet.Replicas),
+		"current":   strconv.Itoa(int(statefulSet.CurrentReplicas)),
+		"updated":   strconv.Itoa(int(statefulSet.UpdatedReplicas)),
+		"service":   statefulSet.ServiceName,
+	}
+}
+
+func StatefulSetListsToMaps(statefulSetLists map[string][]domain.StatefulSet) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, statefulSets := range statefulSetLists {
+		maps := make([]map[string]string, len(statefulSets))
+		for i, statefulSet := range statefulSets {
+			maps[i] = StatefulSetToMap(statefulSet)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+func DaemonSetToMap(daemonSet domain.DaemonSet) map[string]string {
+	return map[string]string{
+		"name":       daemonSet.Name,
+		"namespace":  daemonSet.Namespace,
+		"cluster":    daemonSet.Context,
+		"desired":    strconv.Itoa(int(daemonSet.Desired)),
+		"current":    strconv.Itoa(int(daemonSet.Current)),
+		"ready":      strconv.Itoa(int(daemonSet.Ready)),
+		"up_to_date": strconv.Itoa(int(daemonSet.UpToDate)),
+		"available":  strconv.Itoa(int(daemonSet.Available)),
+	}
+}
+
+func DaemonSetListsToMaps(daemonSetLists map[string][]domain.DaemonSet) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, daemonSets := range daemonSetLists {
+		maps := make([]map[string]string, len(daemonSets))
+		for i, daemonSet := range daemonSets {
+			maps[i] = DaemonSetToMap(daemonSet)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+func IngressToMap(ingress domain.Ingress) map[string]string {
+	tls := make([]string, len(ingress.TLS))
+	for i, ingressTLS := range ingress.TLS {
+		tls[i] = ingressTLS.Secret
+	}
+	return map[string]string{
+		"name":      ingress.Name,
+		"namespace": ingress.Namespace,
+		"cluster":   ingress.Context,
+		"class":     ingress.Class,
+		"gateways":  strings.Join(ingress.Parents, ","),
+		"hosts":     strings.Join(ingress.Hosts, ","),
+		"address":   strings.Join(ingress.Address, ","),
+		"routes":    strconv.Itoa(len(ingress.Routes)),
+		"tls":       strings.Join(tls, ","),
+	}
+}
+
+func IngressListsToMaps(ingressLists map[string][]domain.Ingress) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, ingresses := range ingressLists {
+		maps := make([]map[string]string, len(ingresses))
+		for i, ingress := range ingresses {
+			maps[i] = IngressToMap(ingress)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+// PersistentVolumeClaimToMap marks the pending claims with the highlight key
+func PersistentVolumeClaimToMap(claim domain.PersistentVolumeClaim) map[string]string {
+	highlight := ""
+	if claim.Status == domain.ClaimPending || claim.Status == domain.ClaimLost {
+		highlight = "warning"
+	}
+	return map[string]string{
+		"name":          claim.Name,
+		"namespace":     claim.Namespace,
+		"cluster":       claim.Context,
+		"status":        claim.Status,
+		"volume":        claim.Volume,
+		"capacity":      claim.Capacity,
+		"access_modes":  strings.Join(claim.AccessModes, ","),
+		"storage_class": claim.StorageClass,
+		"mounted_by":    strings.Join(claim.MountedBy, ","),
+		"reason":        claim.Reason,
+		"highlight":     highlight,
+	}
+}
+
+func PersistentVolumeClaimListsToMaps(claimLists map[string][]domain.PersistentVolumeClaim) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, claims := range claimLists {
+		maps := make([]map[string]string, len(claims))
+		for i, claim := range claims {
+			maps[i] = PersistentVolumeClaimToMap(claim)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+func PersistentVolumeToMap(volume domain.PersistentVolume) map[string]string {
+	return map[string]string{
+		"name":           volume.Name,
+		"cluster":        volume.Context,
+		"status":         volume.Status,
+		"claim":          volume.Claim,
+		"capacity":       volume.Capacity,
+		"access_modes":   strings.Join(volume.AccessModes, ","),
+		"reclaim_policy": volume.ReclaimPolicy,
+		"storage_class":  volume.StorageClass,
+		"reason":         volume.Reason,
+	}
+}
+
+func PersistentVolumeListsToMaps(volumeLists map[string][]domain.PersistentVolume) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, volumes := range volumeLists {
+		maps := make([]map[string]string, len(volumes))
+		for i, volume := range volumes {
+			maps[i] = PersistentVolumeToMap(volume)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+// HorizontalPodAutoscalerToMap marks the autoscalers that can't scale with the highlight key
+func HorizontalPodAutoscalerToMap(autoscaler domain.HorizontalPodAutoscaler) map[string]string {
+	targets := make([]string, len(autoscaler.Metrics))
+	for i, metric := range autoscaler.Metrics {
+		targets[i] = metric.Current + "/" + metric.Target
+	}
+	conditions := []string{}
+	highlight := ""
+	for _, condition := range autoscaler.Conditions {
+		switch {
+		case condition.Type == "ScalingLimited" && condition.Status == "True":
+			conditions = append(conditions, condition.Type)
+		case condition.Type != "ScalingLimited" && condition.Status != "True":
+			conditions = append(conditions, "!"+condition.Type)
+			highlight = "warning"
+		}
+	}
+	return map[string]string{
+		"name":       autoscaler.Name,
+		"namespace":  autoscaler.Namespace,
+		"cluster":    autoscaler.Context,
+		"reference":  autoscaler.TargetKind + "/" + autoscaler.TargetName,
+		"targets":    strings.Join(targets, ","),
+		"min":        strconv.Itoa(int(autoscaler.MinReplicas)),
+		"max":        strconv.Itoa(int(autoscaler.MaxReplicas)),
+		"replicas":   strconv.Itoa(int(autoscaler.CurrentReplicas)),
+		"conditions": strings.Join(conditions, ","),
+		"highlight":  highlight,
+	}
+}
+
+func HorizontalPodAutoscalerListsToMaps(autoscalerLists map[string][]domain.HorizontalPodAutoscaler) map[string][]map[string]string {
+	result := make(map[string][]map[string]string)
+	for cluster, autoscalers := range autoscalerLists {
+		maps := make([]map[string]string, len(autoscalers))
+		for i, autoscaler := range autoscalers {
+			maps[i] = HorizontalPodAutoscalerToMap(autoscaler)
+		}
+		result[cluster] = maps
+	}
+	return result
+}
+
+// formatDuration rounds the duration to its two biggest units, like 1h5m or 3d2h
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Second)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm%ds", int(d.Minutes()), int(d.Seconds())%60)
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+}
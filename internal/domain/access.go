@@ -0,0 +1,34 @@
+package domain
+
+// Permission is an operation of the user checked against the RBAC of the cluster
+type Permission string
+
+const (
+	PermissionList        Permission = "list"
+	PermissionGet         Permission = "get"
+	PermissionDelete      Permission = "delete"
+	PermissionPatch       Permission = "patch"
+	PermissionExec        Permission = "exec"
+	PermissionPortForward Permission = "port-forward"
+	PermissionLogs        Permission = "logs"
+	PermissionDebug       Permission = "debug"
+	PermissionTrigger     Permission = "trigger"
+)
+
+// Access is what the current user can do with a resource type in a namespace
+type Access struct {
+	Context   string
+	Namespace string
+	// Denied maps every permission the user lacks to the verb and resource
+	// checked, like "create pods/exec"
+	Denied map[Permission]string
+	// Failed maps every permission whose review failed to the error, they
+	// are reviewed again later
+	Failed map[Permission]string
+}
+
+// Can reports if the permission wasn't denied, a failed review doesn't deny it
+func (a Access) Can(permission Permission) bool {
+	_, denied := a.Denied[permission]
+	return !denied
+}
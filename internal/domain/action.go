@@ -0,0 +1,26 @@
+package domain
+
+// Action is an operation of the user that changes a cluster or runs code in it
+type Action string
+
+const (
+	ActionExec        Action = "exec"
+	ActionDebug       Action = "debug"
+	ActionCopy        Action = "copy"
+	ActionPortForward Action = "port-forward"
+	ActionDelete      Action = "delete"
+	ActionScale       Action = "scale"
+	ActionEdit        Action = "edit"
+	ActionSuspend     Action = "suspend"
+	ActionTrigger     Action = "trigger"
+	ActionRestart     Action = "restart"
+)
+
+// Destructive reports if the action removes or changes resources
+func (a Action) Destructive() bool {
+	switch a {
+	case ActionDelete, ActionScale, ActionEdit, ActionRestart:
+		return true
+	}
+	return false
+}
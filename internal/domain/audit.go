@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// AuditStarted is the outcome of a long action, like an exec session, when it
+// starts. Its result is recorded in another entry when it ends.
+const AuditStarted = "started"
+
+// AuditEntry is an action of the user recorded in the audit journal
+type AuditEntry struct {
+	Time      time.Time `json:"time"`
+	Context   string    `json:"context"`
+	Namespace string    `json:"namespace"`
+	// Object is the kind and name of the resource, like "pod/web-1"
+	Object  string `json:"object"`
+	Action  Action `json:"action"`
+	Command string `json:"command"`
+	Outcome string `json:"outcome"`
+}
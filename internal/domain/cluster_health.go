@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Status of the health check of a cluster
+const (
+	ClusterChecking     = "Checking"
+	ClusterHealthy      = "Healthy"
+	ClusterUnauthorized = "Unauthorized"
+	ClusterUnreachable  = "Unreachable"
+)
+
+// ClusterHealth is the result of the health check of a context
+type ClusterHealth struct {
+	Context   string
+	Status    string
+	Version   string
+	Latency   time.Duration
+	Error     string
+	CheckedAt time.Time
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "errors"
+
+// ErrConfigMapChanged is returned when a value was changed by someone else while it was edited
+var ErrConfigMapChanged = errors.New("the configmap changed since the value was read")
+
+// Formats detected for the values of a ConfigMap
+const (
+	FormatJSON       = "json"
+	FormatYAML       = "yaml"
+	FormatProperties = "properties"
+	FormatText       = "text"
+)
+
+// ConfigMap the struct for the configmap information
+type ConfigMap struct {
+	Name      string            `json:"name,omitempty"`
+	Namespace string            `json:"namespace,omitempty"`
+	Context   string            `json:"context,omitempty"`
+	Data      map[string]string `json:"data,omitempty"`
+	// BinaryKeys are the keys of binaryData, their values are not loaded
+	BinaryKeys []string `json:"binary_keys,omitempty"`
+}
+
+// ConfigMapKey a key of the configmap with the size and the format of its value
+type ConfigMapKey struct {
+	Name   string `json:"name,omitempty"`
+	Size   int    `json:"size,omitempty"`
+	Format string `json:"format,omitempty"`
+}
+
+// ConfigMapReference is a pod that uses the configmap
+type ConfigMapReference struct {
+	Pod       string `json:"pod,omitempty"`
+	Container string `json:"container,omitempty"`
+	// Kind is how it is used: volume, envFrom or env
+	Kind string `json:"kind,omitempty"`
+	// Key is the key used by an env var, empty for the whole configmap
+	Key string `json:"key,omitempty"`
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// CronJob the struct for the cronjob information
+type CronJob struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Context   string `json:"context,omitempty"`
+	Schedule  string `json:"schedule,omitempty"`
+	// TimeZone of the schedule, the one of the controller when empty
+	TimeZone     string    `json:"time_zone,omitempty"`
+	Suspend      bool      `json:"suspend,omitempty"`
+	Active       int       `json:"active,omitempty"`
+	LastSchedule time.Time `json:"last_schedule,omitempty"`
+	// NextRun is zero when the cronjob is suspended or the schedule is invalid
+	NextRun time.Time `json:"next_run,omitempty"`
+}
@@ -0,0 +1,13 @@
+package domain
+
+// DaemonSet the struct for the daemonset information
+type DaemonSet struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Context   string `json:"context,omitempty"`
+	Desired   int32  `json:"desired,omitempty"`
+	Current   int32  `json:"current,omitempty"`
+	Ready     int32  `json:"ready,omitempty"`
+	UpToDate  int32  `json:"up_to_date,omitempty"`
+	Available int32  `json:"available,omitempty"`
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type ContainerSelectionError struct {
 	Containers []string
@@ -9,3 +12,53 @@ type ContainerSelectionError struct {
 func (e *ContainerSelectionError) Error() string {
 	return fmt.Sprintf("multiple containers found, please select one: %v", e.Containers)
 }
+
+// ShellNotFoundError is returned when none of the preferred shells exist in the container
+type ShellNotFoundError struct {
+	Container string
+	Shells    []string
+}
+
+func (e *ShellNotFoundError) Error() string {
+	return fmt.Sprintf("no shell found in container %q (tried %s), the image may be distroless: run a custom command instead",
+		e.Container, strings.Join(e.Shells, ", "))
+}
+
+// ContextError is the failure of a context and namespace while listing resources
+type ContextError struct {
+	Context   string
+	Namespace string
+	Err       error
+}
+
+func (e ContextError) Error() string {
+	if e.Namespace == AllNamespaces {
+		return fmt.Sprintf("context %s: %v", e.Context, e.Err)
+	}
+	return fmt.Sprintf("context %s, namespace %s: %v", e.Context, e.Namespace, e.Err)
+}
+
+func (e ContextError) Unwrap() error {
+	return e.Err
+}
+
+// MultiContextError holds every failing context and namespace of a listing,
+// the results of the others are returned with it
+type MultiContextError struct {
+	Errors []ContextError
+}
+
+func (e *MultiContextError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	return fmt.Sprintf("%d contexts or namespaces failed, first: %v", len(e.Errors), e.Errors[0])
+}
+
+func (e *MultiContextError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
@@ -0,0 +1,32 @@
+package domain
+
+// HorizontalPodAutoscaler the struct for the horizontalpodautoscaler information
+type HorizontalPodAutoscaler struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Context   string `json:"context,omitempty"`
+	// TargetKind and TargetName are the workload scaled, like Deployment web
+	TargetKind      string                `json:"target_kind,omitempty"`
+	TargetName      string                `json:"target_name,omitempty"`
+	MinReplicas     int32                 `json:"min_replicas,omitempty"`
+	MaxReplicas     int32                 `json:"max_replicas,omitempty"`
+	CurrentReplicas int32                 `json:"current_replicas,omitempty"`
+	DesiredReplicas int32                 `json:"desired_replicas,omitempty"`
+	Metrics         []AutoscalerMetric    `json:"metrics,omitempty"`
+	Conditions      []AutoscalerCondition `json:"conditions,omitempty"`
+}
+
+// AutoscalerMetric a metric of the autoscaler with its current value and its target
+type AutoscalerMetric struct {
+	Name    string `json:"name,omitempty"`
+	Current string `json:"current,omitempty"`
+	Target  string `json:"target,omitempty"`
+}
+
+// AutoscalerCondition a condition of the autoscaler, like AbleToScale or ScalingLimited
+type AutoscalerCondition struct {
+	Type    string `json:"type,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+// Ingress the struct for the routing of an Ingress or a Gateway API HTTPRoute
+type Ingress struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Context   string `json:"context,omitempty"`
+	// Class is the ingress class, Parents the gateways of an HTTPRoute
+	Class   string         `json:"class,omitempty"`
+	Parents []string       `json:"parents,omitempty"`
+	Hosts   []string       `json:"hosts,omitempty"`
+	Address []string       `json:"address,omitempty"`
+	Routes  []IngressRoute `json:"routes,omitempty"`
+	TLS     []IngressTLS   `json:"tls,omitempty"`
+}
+
+// IngressRoute a host and path routed to the port of a service
+type IngressRoute struct {
+	Host      string `json:"host,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Service   string `json:"service,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// Port is the number or the name of the port of the service
+	Port string `json:"port,omitempty"`
+}
+
+// IngressTLS the hosts served with the certificate of a secret, the Namespace
+// of the secret is set when it differs from the one of the ingress
+type IngressTLS struct {
+	Hosts     []string `json:"hosts,omitempty"`
+	Secret    string   `json:"secret,omitempty"`
+	Namespace string   `json:"namespace,omitempty"`
+}
+
+// RouteStatus is a route with the state of its backend service
+type RouteStatus struct {
+	IngressRoute
+	ServiceFound   bool   `json:"service_found"`
+	PortFound      bool   `json:"port_found"`
+	Endpoints      int    `json:"endpoints"`
+	ReadyEndpoints int    `json:"ready_endpoints"`
+	Error          string `json:"error,omitempty"`
+}
+
+// TLSStatus is a TLS secret with the expiry of its certificate
+type TLSStatus struct {
+	IngressTLS
+	NotAfter time.Time `json:"not_after,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// IngressRouting is the resolution of the routes and certificates of an ingress
+type IngressRouting struct {
+	Routes []RouteStatus `json:"routes,omitempty"`
+	TLS    []TLSStatus   `json:"tls,omitempty"`
+}
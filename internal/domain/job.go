@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// Status of a job
+const (
+	JobRunning   = "Running"
+	JobComplete  = "Complete"
+	JobFailed    = "Failed"
+	JobSuspended = "Suspended"
+)
+
+// Job the struct for the job information
+type Job struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Context   string `json:"context,omitempty"`
+	// Completions is the number of pods that must succeed
+	Completions int32  `json:"completions,omitempty"`
+	Succeeded   int32  `json:"succeeded,omitempty"`
+	Active      int32  `json:"active,omitempty"`
+	Failed      int32  `json:"failed,omitempty"`
+	Status      string `json:"status,omitempty"`
+	// CronJob is the cronjob that created the job, if any
+	CronJob        string    `json:"cron_job,omitempty"`
+	StartTime      time.Time `json:"start_time,omitempty"`
+	CompletionTime time.Time `json:"completion_time,omitempty"`
+}
+
+// Duration is the time the job ran, until now when it didn't end
+func (j Job) Duration(now time.Time) time.Duration {
+	if j.StartTime.IsZero() {
+		return 0
+	}
+	if j.CompletionTime.IsZero() {
+		return now.Sub(j.StartTime)
+	}
+	return j.CompletionTime.Sub(j.StartTime)
+}
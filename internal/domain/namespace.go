@@ -0,0 +1,10 @@
+package domain
+
+// AllNamespaces is the namespace used to list the resources of every namespace
+const AllNamespaces = ""
+
+// Namespace is a namespace with the contexts where it exists
+type Namespace struct {
+	Name     string
+	Contexts []string
+}
@@ -6,9 +6,41 @@ type Pod struct {
 	Namespace         string              `json:"namespace,omitempty"`
 	Context           string              `json:"context,omitempty"`
 	State             string              `json:"state,omitempty"`
+	Ready             bool                `json:"ready,omitempty"`
+	Labels            map[string]string   `json:"labels,omitempty"`
+	Containers        []Container         `json:"containers,omitempty"`
 	ContainerStatuses []ContainerStatuses `json:"container_statuses,omitempty"`
 }
 
+// NamedPort returns the number of the container port with the name
+func (p Pod) NamedPort(name string) (int32, bool) {
+	if name == "" {
+		return 0, false
+	}
+	for _, container := range p.Containers {
+		for _, port := range container.Ports {
+			if port.Name == name {
+				return port.ContainerPort, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// Container the struct for save the container spec
+type Container struct {
+	Name  string          `json:"name,omitempty"`
+	Image string          `json:"image,omitempty"`
+	Ports []ContainerPort `json:"ports,omitempty"`
+}
+
+// ContainerPort a port declared by a container
+type ContainerPort struct {
+	Name          string `json:"name,omitempty"`
+	ContainerPort int32  `json:"container_port,omitempty"`
+	Protocol      string `json:"protocol,omitempty"`
+}
+
 // ContainerStatuses the struct for save container status
 type ContainerStatuses struct {
 	Name         string `json:"name,omitempty"`
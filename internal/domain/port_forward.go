@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrPortForwardLost is returned when the connection with the pod of a port-forward is lost
+var ErrPortForwardLost = errors.New("lost connection to pod")
+
+// Status of the port-forward sessions
+const (
+	PortForwardStarting     = "Starting"
+	PortForwardActive       = "Active"
+	PortForwardReconnecting = "Reconnecting"
+	PortForwardFailed       = "Failed"
+	PortForwardStopped      = "Stopped"
+)
+
+// PortForwardTarget is the pod that receives the forwarded connections
+type PortForwardTarget struct {
+	Context   string `json:"context,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Pod       string `json:"pod,omitempty"`
+	// Deployment is set when the pod was chosen from a deployment, a new pod
+	// of it is used when the session reconnects
+	Deployment string `json:"deployment,omitempty"`
+	// Service is set when the connections go to a ready pod of the service,
+	// the ports are ports of the service
+	Service string `json:"service,omitempty"`
+}
+
+// ForwardedPort a local port listening for a port of the pod
+type ForwardedPort struct {
+	Local  uint16 `json:"local,omitempty"`
+	Remote uint16 `json:"remote,omitempty"`
+}
+
+// PortForward is the state of a port-forward session
+type PortForward struct {
+	ID          int               `json:"id,omitempty"`
+	Target      PortForwardTarget `json:"target,omitempty"`
+	Ports       []string          `json:"ports,omitempty"`
+	Bound       []ForwardedPort   `json:"bound,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	Reconnect   bool              `json:"reconnect,omitempty"`
+	Restarts    int               `json:"restarts,omitempty"`
+	Connections int               `json:"connections,omitempty"`
+	LastError   string            `json:"last_error,omitempty"`
+	StartedAt   time.Time         `json:"started_at,omitempty"`
+}
@@ -0,0 +1,16 @@
+package domain
+
+// Secret the struct for the secret information, the values are never listed
+type Secret struct {
+	Name      string      `json:"name,omitempty"`
+	Namespace string      `json:"namespace,omitempty"`
+	Context   string      `json:"context,omitempty"`
+	Type      string      `json:"type,omitempty"`
+	Keys      []SecretKey `json:"keys,omitempty"`
+}
+
+// SecretKey a key of the secret with the size of its value
+type SecretKey struct {
+	Name string `json:"name,omitempty"`
+	Size int    `json:"size,omitempty"`
+}
@@ -0,0 +1,37 @@
+package domain
+
+// Service the struct for the service information
+type Service struct {
+	Name        string            `json:"name,omitempty"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Context     string            `json:"context,omitempty"`
+	Type        string            `json:"type,omitempty"`
+	ClusterIP   string            `json:"cluster_ip,omitempty"`
+	ExternalIPs []string          `json:"external_ips,omitempty"`
+	Selector    map[string]string `json:"selector,omitempty"`
+	Ports       []ServicePort     `json:"ports,omitempty"`
+}
+
+// ServicePort a port exposed by the service
+type ServicePort struct {
+	Name     string `json:"name,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+	Port     int32  `json:"port,omitempty"`
+	// TargetPort is the number or the name of the port in the pods
+	TargetPort string `json:"target_port,omitempty"`
+	NodePort   int32  `json:"node_port,omitempty"`
+}
+
+// ServiceEndpoint a backend of the service taken from its EndpointSlices
+type ServiceEndpoint struct {
+	Addresses []string `json:"addresses,omitempty"`
+	Ports     []string `json:"ports,omitempty"`
+	Ready     bool     `json:"ready"`
+	// Serving is true when the backend answers, even while terminating
+	Serving     bool   `json:"serving"`
+	Terminating bool   `json:"terminating"`
+	Pod         string `json:"pod,omitempty"`
+	Node        string `json:"node,omitempty"`
+	Zone        string `json:"zone,omitempty"`
+	Slice       string `json:"slice,omitempty"`
+}
@@ -0,0 +1,14 @@
+package domain
+
+// StatefulSet the struct for the statefulset information
+type StatefulSet struct {
+	Name            string `json:"name,omitempty"`
+	Namespace       string `json:"namespace,omitempty"`
+	Context         string `json:"context,omitempty"`
+	Replicas        int32  `json:"replicas,omitempty"`
+	ReadyReplicas   int32  `json:"ready_replicas,omitempty"`
+	CurrentReplicas int32  `json:"current_replicas,omitempty"`
+	UpdatedReplicas int32  `json:"updated_replicas,omitempty"`
+	// ServiceName is the headless service that names the pods
+	ServiceName string `json:"service_name,omitempty"`
+}
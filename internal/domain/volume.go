@@ -0,0 +1,38 @@
+package domain
+
+// Phases of a PersistentVolumeClaim
+const (
+	ClaimPending = "Pending"
+	ClaimBound   = "Bound"
+	ClaimLost    = "Lost"
+)
+
+// PersistentVolumeClaim the struct for the persistentvolumeclaim information
+type PersistentVolumeClaim struct {
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Context   string `json:"context,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Volume    string `json:"volume,omitempty"`
+	// Capacity is the capacity of the volume, or the request while pending
+	Capacity     string   `json:"capacity,omitempty"`
+	StorageClass string   `json:"storage_class,omitempty"`
+	AccessModes  []string `json:"access_modes,omitempty"`
+	// MountedBy are the pods with a volume of the claim
+	MountedBy []string `json:"mounted_by,omitempty"`
+	// Reason explains a pending claim with its latest event
+	Reason string `json:"reason,omitempty"`
+}
+
+// PersistentVolume the struct for the persistentvolume information, cluster-scoped
+type PersistentVolume struct {
+	Name          string   `json:"name,omitempty"`
+	Context       string   `json:"context,omitempty"`
+	Status        string   `json:"status,omitempty"`
+	Claim         string   `json:"claim,omitempty"`
+	Capacity      string   `json:"capacity,omitempty"`
+	StorageClass  string   `json:"storage_class,omitempty"`
+	AccessModes   []string `json:"access_modes,omitempty"`
+	ReclaimPolicy string   `json:"reclaim_policy,omitempty"`
+	Reason        string   `json:"reason,omitempty"`
+}
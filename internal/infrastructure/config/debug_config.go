@@ -0,0 +1,17 @@
+package config
+
+// DefaultDebugImages are offered when the config doesn't set any image
+var DefaultDebugImages = []string{"busybox:latest", "nicolaka/netshoot:latest"}
+
+// DebugConfig the options for the ephemeral debug containers
+type DebugConfig struct {
+	Images []string `json:"images,omitempty"`
+}
+
+// GetImages return the images offered for the debug containers
+func (d DebugConfig) GetImages() []string {
+	if len(d.Images) == 0 {
+		return DefaultDebugImages
+	}
+	return d.Images
+}
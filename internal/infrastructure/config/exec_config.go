@@ -0,0 +1,81 @@
+package config
+
+import (
+	"lazykube/internal/domain"
+	"regexp"
+)
+
+// DefaultShells is the shell preference used when the config doesn't set one
+var DefaultShells = []string{"bash", "sh", "ash"}
+
+// Exec modes, embedded shows the session in a tui widget and suspend hands
+// the real terminal to the session until it ends
+const (
+	ExecModeEmbedded = "embedded"
+	ExecModeSuspend  = "suspend"
+)
+
+// ExecConfig the options used when a session is opened in a container
+type ExecConfig struct {
+	Mode     string        `json:"mode,omitempty"`
+	Shells   []string      `json:"shells,omitempty"`
+	Defaults []ExecDefault `json:"defaults,omitempty"`
+}
+
+// ExecDefault is a command used for the containers that match the image
+// regex and all the labels. Empty fields match everything.
+type ExecDefault struct {
+	Image   string            `json:"image,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Command []string          `json:"command"`
+}
+
+// Suspend reports if the sessions must use the real terminal
+func (e ExecConfig) Suspend() bool {
+	return e.Mode == ExecModeSuspend
+}
+
+// GetShells return the shells in order of preference
+func (e ExecConfig) GetShells() []string {
+	if len(e.Shells) == 0 {
+		return DefaultShells
+	}
+	return e.Shells
+}
+
+// CommandFor return the first default command that match the container of the pod,
+// or nil when the shell preference must be used
+func (e ExecConfig) CommandFor(pod domain.Pod, containerName string) []string {
+	image := ""
+	for _, container := range pod.Containers {
+		if container.Name == containerName || (containerName == "" && len(pod.Containers) == 1) {
+			image = container.Image
+		}
+	}
+
+	for _, def := range e.Defaults {
+		if len(def.Command) == 0 {
+			continue
+		}
+		if def.Image != "" {
+			re, err := regexp.Compile(def.Image)
+			if err != nil || !re.MatchString(image) {
+				continue
+			}
+		}
+		if !matchLabels(def.Labels, pod.Labels) {
+			continue
+		}
+		return def.Command
+	}
+	return nil
+}
+
+func matchLabels(selector, labels map[string]string) bool {
+	for key, value := range selector {
+		if labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package config
+
+import "lazykube/internal/domain"
+
+// PortForwardProfile is a named set of port-forwards that are started together
+type PortForwardProfile struct {
+	Name     string             `json:"name"`
+	Forwards []PortForwardEntry `json:"forwards"`
+}
+
+// PortForwardEntry is one port-forward of a profile, it targets a pod, a
+// deployment or a service. Ports use the "local:remote" format.
+type PortForwardEntry struct {
+	Context    string   `json:"context"`
+	Namespace  string   `json:"namespace"`
+	Pod        string   `json:"pod,omitempty"`
+	Deployment string   `json:"deployment,omitempty"`
+	Service    string   `json:"service,omitempty"`
+	Ports      []string `json:"ports"`
+	Reconnect  bool     `json:"reconnect,omitempty"`
+}
+
+// Target return the resource of the entry
+func (e PortForwardEntry) Target() domain.PortForwardTarget {
+	return domain.PortForwardTarget{
+		Context:    e.Context,
+		Namespace:  e.Namespace,
+		Pod:        e.Pod,
+		Deployment: e.Deployment,
+		Service:    e.Service,
+	}
+}
+
+// Profile return the port-forward profile with the name
+func (c *Config) Profile(name string) (PortForwardProfile, bool) {
+	for _, profile := range c.PortForwardProfiles {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return PortForwardProfile{}, false
+}
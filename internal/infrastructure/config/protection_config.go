@@ -0,0 +1,71 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// Protection is how an action on a context is allowed
+type Protection int
+
+const (
+	// ProtectionNone runs the action
+	ProtectionNone Protection = iota
+	// ProtectionConfirm asks the user before the action, destructive ones
+	// need the name of the resource typed
+	ProtectionConfirm
+	// ProtectionDenied refuses the action
+	ProtectionDenied
+)
+
+// compileProtectedContexts compiles the regexes of ProtectedContexts, an
+// invalid one is an error so a typo never leaves a context unprotected
+func (c *Config) compileProtectedContexts() error {
+	protected := make([]*regexp.Regexp, 0, len(c.ProtectedContexts))
+	for _, pattern := range c.ProtectedContexts {
+		compiled, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return fmt.Errorf("invalid protected context %q: %w", pattern, err)
+		}
+		protected = append(protected, compiled)
+	}
+	c.protected = protected
+	return nil
+}
+
+// ProtectionFor returns how the actions are allowed on the context. The
+// read-only mode denies every action, and the contexts matching a regex of
+// ProtectedContexts need a confirmation. A config that wasn't read with
+// ReadConfig compiles them on the first call, and an invalid regex protects
+// every context.
+func (c *Config) ProtectionFor(context string) Protection {
+	if c.ReadOnly {
+		return ProtectionDenied
+	}
+	if c.protected == nil && c.compileProtectedContexts() != nil {
+		return ProtectionConfirm
+	}
+	for _, pattern := range c.protected {
+		if pattern.MatchString(context) {
+			return ProtectionConfirm
+		}
+	}
+	return ProtectionNone
+}
+
+// StrictestProtection returns the strictest protection among the contexts,
+// with the contexts that have it
+func (c *Config) StrictestProtection(contexts []string) (Protection, []string) {
+	strictest, matched := ProtectionNone, []string{}
+	for _, context := range contexts {
+		protection := c.ProtectionFor(context)
+		if protection > strictest {
+			strictest, matched = protection, []string{}
+		}
+		if protection == strictest && !slices.Contains(matched, context) {
+			matched = append(matched, context)
+		}
+	}
+	return strictest, matched
+}
@@ -0,0 +1,61 @@
+package config_test
+
+import (
+	"lazykube/internal/infrastructure/config"
+	"reflect"
+	"testing"
+)
+
+func TestProtectionFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		conf     config.Config
+		context  string
+		expected config.Protection
+	}{
+		{"no protected contexts", config.Config{}, "prod", config.ProtectionNone},
+		{"matching regex", config.Config{ProtectedContexts: []string{".*prod.*"}}, "eu-prod-1", config.ProtectionConfirm},
+		{"whole name only", config.Config{ProtectedContexts: []string{"prod"}}, "prod-2", config.ProtectionNone},
+		{"alternatives", config.Config{ProtectedContexts: []string{"staging|prod"}}, "prod", config.ProtectionConfirm},
+		{"read-only beats protection", config.Config{ReadOnly: true, ProtectedContexts: []string{"prod"}}, "prod", config.ProtectionDenied},
+		{"read-only denies every context", config.Config{ReadOnly: true}, "dev", config.ProtectionDenied},
+		{"invalid regex protects every context", config.Config{ProtectedContexts: []string{".*prod(.*"}}, "dev", config.ProtectionConfirm},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if protection := test.conf.ProtectionFor(test.context); protection != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, protection)
+			}
+		})
+	}
+}
+
+func TestStrictestProtection(t *testing.T) {
+	tests := []struct {
+		name       string
+		conf       config.Config
+		contexts   []string
+		expected   config.Protection
+		matchedCtx []string
+	}{
+		{"no contexts", config.Config{ProtectedContexts: []string{"prod"}}, nil, config.ProtectionNone, []string{}},
+		{"none protected", config.Config{ProtectedContexts: []string{"prod"}}, []string{"dev", "dev"}, config.ProtectionNone, []string{"dev"}},
+		{"one protected", config.Config{ProtectedContexts: []string{"prod"}}, []string{"dev", "prod", "prod"}, config.ProtectionConfirm, []string{"prod"}},
+		{"read-only", config.Config{ReadOnly: true}, []string{"dev", "prod"}, config.ProtectionDenied, []string{"dev", "prod"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			protection, matched := test.conf.StrictestProtection(test.contexts)
+			if protection != test.expected || !reflect.DeepEqual(matched, test.matchedCtx) {
+				t.Errorf("expected %d %v, got %d %v", test.expected, test.matchedCtx, protection, matched)
+			}
+		})
+	}
+}
+
+func TestReadConfig_InvalidProtectedContext(t *testing.T) {
+	writeConfig(t, `{"protected_contexts": ["prod", ".*prod(.*"]}`)
+	if _, err := config.ReadConfig(); err == nil {
+		t.Error("expected an error for the invalid regex")
+	}
+}
@@ -5,14 +5,26 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 )
 
 type Config struct {
-	DefaultNamespaces []string `json:"default_namespaces,omitempty"`
+	DefaultNamespaces   []string             `json:"default_namespaces,omitempty"`
+	ReadOnly            bool                 `json:"readonly,omitempty"`
+	ProtectedContexts   []string             `json:"protected_contexts,omitempty"`
+	Timeout             string               `json:"request_timeout,omitempty"`
+	Refresh             RefreshConfig        `json:"refresh"`
+	Exec                ExecConfig           `json:"exec"`
+	Debug               DebugConfig          `json:"debug"`
+	PortForwardProfiles []PortForwardProfile `json:"port_forward_profiles,omitempty"`
+	AuditFile           string               `json:"audit_file,omitempty"`
+
+	// protected are the compiled regexes of ProtectedContexts
+	protected []*regexp.Regexp
 }
 
 func ReadConfig() (*Config, error) {
-	var config *Config
+	config := &Config{}
 	home, err := os.UserHomeDir()
 	if err != nil {
 		return config, fmt.Errorf("Error getting user home directory: %v", err)
@@ -47,5 +59,14 @@ func ReadConfig() (*Config, error) {
 			return config, fmt.Errorf("Error unmarshalling config file: %v", err)
 		}
 	}
+	if config == nil {
+		config = &Config{}
+	}
+	if err := config.compileProtectedContexts(); err != nil {
+		return config, err
+	}
+	if err := config.Refresh.validate(); err != nil {
+		return config, err
+	}
 	return config, nil
 }
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultRefreshInterval is the auto-refresh interval when the config doesn't set one
+const DefaultRefreshInterval = 10 * time.Second
+
+// RefreshConfig the intervals of the auto-refresh of the resources table, as
+// durations like "30s". Types overrides the interval by resource type, like
+// "Pods", and a "0" interval disables the auto-refresh.
+type RefreshConfig struct {
+	Interval string            `json:"interval,omitempty"`
+	Types    map[string]string `json:"types,omitempty"`
+}
+
+// IntervalFor returns the auto-refresh interval of the resource type, 0 when
+// disabled. An interval that isn't set uses the default, the invalid ones are
+// refused by ReadConfig.
+func (r RefreshConfig) IntervalFor(resourceType string) time.Duration {
+	value, ok := r.Types[resourceType]
+	if !ok {
+		value = r.Interval
+	}
+	interval, err := parseInterval(value)
+	if err != nil || value == "" {
+		return DefaultRefreshInterval
+	}
+	return interval
+}
+
+// validate checks every interval, so a typo doesn't poll with the default
+func (r RefreshConfig) validate() error {
+	if _, err := parseInterval(r.Interval); err != nil {
+		return fmt.Errorf("invalid refresh interval: %w", err)
+	}
+	for resourceType, value := range r.Types {
+		if _, err := parseInterval(value); err != nil {
+			return fmt.Errorf("invalid refresh interval of %s: %w", resourceType, err)
+		}
+	}
+	return nil
+}
+
+// parseInterval parses a duration like "30s", "0" disables the refresh and an
+// empty one is the default
+func parseInterval(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if interval < 0 {
+		return 0, fmt.Errorf("negative duration %q", value)
+	}
+	return interval, nil
+}
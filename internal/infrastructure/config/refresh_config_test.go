@@ -0,0 +1,68 @@
+package config_test
+
+import (
+	"lazykube/internal/infrastructure/config"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRefreshConfig_IntervalFor(t *testing.T) {
+	refresh := config.RefreshConfig{
+		Interval: "30s",
+		Types:    map[string]string{"Pods": "5s", "Secrets": "0"},
+	}
+	tests := []struct {
+		resourceType string
+		expected     time.Duration
+	}{
+		{"Pods", 5 * time.Second},
+		{"Secrets", 0},
+		{"Deployments", 30 * time.Second},
+	}
+	for _, test := range tests {
+		if interval := refresh.IntervalFor(test.resourceType); interval != test.expected {
+			t.Errorf("expected %s for %s, got %s", test.expected, test.resourceType, interval)
+		}
+	}
+	if interval := (config.RefreshConfig{}).IntervalFor("Pods"); interval != config.DefaultRefreshInterval {
+		t.Errorf("expected the default interval, got %s", interval)
+	}
+}
+
+func TestReadConfig_InvalidRefreshInterval(t *testing.T) {
+	for _, content := range []string{
+		`{"refresh": {"interval": "30"}}`,
+		`{"refresh": {"interval": "-5s"}}`,
+		`{"refresh": {"types": {"Pods": "1 m"}}}`,
+	} {
+		writeConfig(t, content)
+		if _, err := config.ReadConfig(); err == nil {
+			t.Errorf("expected an error for %s", content)
+		}
+	}
+
+	writeConfig(t, `{"refresh": {"interval": "0", "types": {"Pods": "1m"}}}`)
+	conf, err := config.ReadConfig()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if conf.Refresh.IntervalFor("Jobs") != 0 || conf.Refresh.IntervalFor("Pods") != time.Minute {
+		t.Errorf("unexpected intervals %+v", conf.Refresh)
+	}
+}
+
+// writeConfig writes the config file in a temporary home
+func writeConfig(t *testing.T, content string) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	dir := filepath.Join(home, ".config", "lazykube")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
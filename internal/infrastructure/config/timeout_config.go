@@ -0,0 +1,16 @@
+package config
+
+import "time"
+
+// DefaultRequestTimeout bounds the requests to the clusters when the config doesn't set a timeout
+const DefaultRequestTimeout = 15 * time.Second
+
+// RequestTimeout returns the timeout of the requests to the clusters, set in
+// the config as a duration like "10s". Streams like logs and exec sessions
+// don't use it.
+func (c *Config) RequestTimeout() time.Duration {
+	if timeout, err := time.ParseDuration(c.Timeout); err == nil && timeout > 0 {
+		return timeout
+	}
+	return DefaultRequestTimeout
+}
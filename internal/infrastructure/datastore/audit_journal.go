@@ -0,0 +1,80 @@
+package datastore
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// auditJournal stores an audit entry by line in a JSON lines file
+type auditJournal struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewAuditJournal return the journal stored in the file
+func NewAuditJournal(path string) port.AuditJournal {
+	return &auditJournal{path: path}
+}
+
+// DefaultAuditFile is the journal used when the config doesn't set one
+func DefaultAuditFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "audit.jsonl"
+	}
+	return filepath.Join(home, ".config", "lazykube", "audit.jsonl")
+}
+
+func (aj *auditJournal) Append(entry domain.AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	aj.mu.Lock()
+	defer aj.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(aj.path), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(aj.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// ReadAll returns the entries in the order they were written, skipping the
+// lines that aren't valid
+func (aj *auditJournal) ReadAll() ([]domain.AuditEntry, error) {
+	aj.mu.Lock()
+	defer aj.mu.Unlock()
+	file, err := os.Open(aj.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := []domain.AuditEntry{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry domain.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,64 @@
+package datastore_test
+
+import (
+	"lazykube/internal/domain"
+	"lazykube/internal/infrastructure/datastore"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAuditJournal_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "audit.jsonl")
+	journal := datastore.NewAuditJournal(path)
+
+	entries, err := journal.ReadAll()
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("expected no entries without the file, got %v %v", entries, err)
+	}
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	written := []domain.AuditEntry{
+		{Time: now, Context: "prod", Namespace: "web", Object: "pod/web-1", Action: domain.ActionExec, Command: "kubectl exec -it web-1 -- sh", Outcome: domain.AuditStarted},
+		{Time: now.Add(time.Minute), Context: "prod", Namespace: "web", Object: "pod/web-1", Action: domain.ActionExec, Command: "kubectl exec -it web-1 -- sh", Outcome: "ok"},
+	}
+	for _, entry := range written {
+		if err := journal.Append(entry); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	entries, err = journal.ReadAll()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(entries) != len(written) {
+		t.Fatalf("expected %d entries, got %+v", len(written), entries)
+	}
+	for i, entry := range entries {
+		if !entry.Time.Equal(written[i].Time) || entry.Outcome != written[i].Outcome || entry.Command != written[i].Command {
+			t.Errorf("expected %+v, got %+v", written[i], entry)
+		}
+	}
+}
+
+func TestAuditJournal_SkipsMalformedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	content := `{"context":"prod","action":"delete","outcome":"ok"}
+not json
+{"context":"dev","action":"scale","outc
+{"context":"dev","action":"scale","outcome":"error: forbidden"}
+`
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := datastore.NewAuditJournal(path).ReadAll()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(entries) != 2 || entries[0].Context != "prod" || entries[1].Outcome != "error: forbidden" {
+		t.Errorf("expected the two valid entries, got %+v", entries)
+	}
+}
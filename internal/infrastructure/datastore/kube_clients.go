@@ -1,7 +1,8 @@
 package datastore
 
 import (
-	"fmt"
+	"log/slog"
+	"net/http"
 	"os"
 	"path/filepath"
 
@@ -25,7 +26,14 @@ func NewKubeConnections() (map[string]kubernetes.Interface, map[string]*rest.Con
 	configs := map[string]*rest.Config{}
 
 	for key := range name {
-		kubeConfig, _ := buildConfigFromFlags(key, kubeConfigPath)
+		kubeConfig, err := buildConfigFromFlags(key, kubeConfigPath)
+		if err != nil {
+			slog.Warn("skipping invalid context of the kubeconfig", "context", key, "error", err)
+			continue
+		}
+		kubeConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &timingTransport{next: rt, context: key}
+		})
 
 		client, err := kubernetes.NewForConfig(kubeConfig)
 		if err != nil {
@@ -53,7 +61,7 @@ func getAllContext(pathToKubeConfig string) map[string]*api.Context {
 		}).RawConfig()
 
 	if err != nil {
-		fmt.Println(err)
+		slog.Error("reading kubeconfig", "path", pathToKubeConfig, "error", err)
 	}
 
 	return config.Contexts
@@ -0,0 +1,30 @@
+package datastore
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// timingTransport logs the duration of every request to the API server of a context
+type timingTransport struct {
+	next    http.RoundTripper
+	context string
+}
+
+func (t *timingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	attrs := []any{
+		"context", t.context,
+		"method", req.Method,
+		"path", req.URL.Path,
+		"duration", time.Since(start),
+	}
+	if err != nil {
+		slog.Debug("api request failed", append(attrs, "error", err)...)
+		return resp, err
+	}
+	slog.Debug("api request", append(attrs, "status", resp.StatusCode)...)
+	return resp, nil
+}
@@ -0,0 +1,99 @@
+package k8s
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"slices"
+	"sync"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type accessGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewAccessGateway return an accessGateway struct
+func NewAccessGateway(client kubernetes.Interface, cluster string) port.AccessGateway {
+	return &accessGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+// Review checks the permissions, every one when none is given, with a
+// SelfSubjectAccessReview. Exec, port-forward, logs and debug always run in
+// pods, whatever the resource type, and a trigger creates a job. The access
+// is returned with the first error, the reviews that failed in Failed.
+func (ag *accessGateway) Review(ctx context.Context, namespace, group, resource string, permissions ...domain.Permission) (domain.Access, error) {
+	checks := map[domain.Permission]authorizationv1.ResourceAttributes{
+		domain.PermissionList:        {Verb: "list", Group: group, Resource: resource},
+		domain.PermissionGet:         {Verb: "get", Group: group, Resource: resource},
+		domain.PermissionDelete:      {Verb: "delete", Group: group, Resource: resource},
+		domain.PermissionPatch:       {Verb: "patch", Group: group, Resource: resource},
+		domain.PermissionExec:        {Verb: "create", Resource: "pods", Subresource: "exec"},
+		domain.PermissionPortForward: {Verb: "create", Resource: "pods", Subresource: "portforward"},
+		domain.PermissionLogs:        {Verb: "get", Resource: "pods", Subresource: "log"},
+		domain.PermissionDebug:       {Verb: "update", Resource: "pods", Subresource: "ephemeralcontainers"},
+		domain.PermissionTrigger:     {Verb: "create", Group: "batch", Resource: "jobs"},
+	}
+
+	if len(permissions) > 0 {
+		for permission := range checks {
+			if !slices.Contains(permissions, permission) {
+				delete(checks, permission)
+			}
+		}
+	}
+
+	var (
+		access = domain.Access{
+			Context:   ag.context,
+			Namespace: namespace,
+			Denied:    map[domain.Permission]string{},
+			Failed:    map[domain.Permission]string{},
+		}
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+	for permission, attributes := range checks {
+		attributes.Namespace = namespace
+		wg.Go(func() {
+			review, err := ag.client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+			}, metav1.CreateOptions{})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				access.Failed[permission] = err.Error()
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			if !review.Status.Allowed {
+				access.Denied[permission] = describeAttributes(attributes)
+			}
+		})
+	}
+	wg.Wait()
+	return access, firstErr
+}
+
+// describeAttributes returns the verb and resource like kubectl auth can-i shows them
+func describeAttributes(attributes authorizationv1.ResourceAttributes) string {
+	resource := attributes.Resource
+	if attributes.Group != "" {
+		resource += "." + attributes.Group
+	}
+	if attributes.Subresource != "" {
+		resource += "/" + attributes.Subresource
+	}
+	return attributes.Verb + " " + resource
+}
@@ -0,0 +1,80 @@
+package k8s_test
+
+import (
+	"context"
+	"errors"
+	"lazykube/internal/domain"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestAccessGateway_Review(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = attributes.Namespace == "dev" && attributes.Verb != "delete" &&
+			attributes.Subresource != "exec" && attributes.Subresource != "ephemeralcontainers" && attributes.Group != "batch"
+		return true, review, nil
+	})
+
+	access, err := k8s.NewAccessGateway(client, "prod").Review(context.Background(), "dev", "apps", "deployments")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !access.Can(domain.PermissionList) || !access.Can(domain.PermissionPortForward) {
+		t.Errorf("expected list and port-forward allowed, denied %v", access.Denied)
+	}
+	if access.Denied[domain.PermissionDelete] != "delete deployments.apps" {
+		t.Errorf("unexpected delete denial %q", access.Denied[domain.PermissionDelete])
+	}
+	if access.Denied[domain.PermissionExec] != "create pods/exec" {
+		t.Errorf("unexpected exec denial %q", access.Denied[domain.PermissionExec])
+	}
+	if !access.Can(domain.PermissionLogs) {
+		t.Errorf("expected logs allowed, denied %q", access.Denied[domain.PermissionLogs])
+	}
+	if access.Denied[domain.PermissionDebug] != "update pods/ephemeralcontainers" {
+		t.Errorf("unexpected debug denial %q", access.Denied[domain.PermissionDebug])
+	}
+	if access.Denied[domain.PermissionTrigger] != "create jobs.batch" {
+		t.Errorf("unexpected trigger denial %q", access.Denied[domain.PermissionTrigger])
+	}
+}
+
+func TestAccessGateway_ReviewKeepsTheReviewsThatSucceeded(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		if review.Spec.ResourceAttributes.Subresource == "exec" {
+			return true, nil, errors.New("connection reset")
+		}
+		review.Status.Allowed = review.Spec.ResourceAttributes.Verb != "delete"
+		return true, review, nil
+	})
+	gateway := k8s.NewAccessGateway(client, "prod")
+
+	access, err := gateway.Review(context.Background(), "dev", "", "pods")
+	if err == nil {
+		t.Fatal("expected the error of the exec review")
+	}
+	if access.Failed[domain.PermissionExec] != "connection reset" || len(access.Failed) != 1 {
+		t.Errorf("expected only the exec review failed, got %v", access.Failed)
+	}
+	if access.Can(domain.PermissionDelete) || !access.Can(domain.PermissionGet) {
+		t.Errorf("expected the other reviews kept, denied %v", access.Denied)
+	}
+
+	access, err = gateway.Review(context.Background(), "dev", "", "pods", domain.PermissionDelete)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(access.Denied) != 1 || access.Can(domain.PermissionDelete) {
+		t.Errorf("expected only delete reviewed and denied, got %v", access.Denied)
+	}
+}
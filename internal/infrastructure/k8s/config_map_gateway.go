@@ -0,0 +1,166 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type configMapGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewConfigMapGateway return a configMapGateway struct
+func NewConfigMapGateway(client kubernetes.Interface, cluster string) port.ConfigMapResourceGateway {
+	return &configMapGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (cg *configMapGateway) GetAll(ctx context.Context, namespace string) ([]domain.ConfigMap, error) {
+	configMapList, err := cg.client.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list configmaps in namespace %s: %w", namespace, err)
+	}
+	configMaps := []domain.ConfigMap{}
+	for _, configMap := range configMapList.Items {
+		configMaps = append(configMaps, cg.addConfigMapEntity(configMap))
+	}
+	return configMaps, nil
+}
+
+func (cg *configMapGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.ConfigMap, error) {
+	configMap, err := cg.client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap %s in namespace %s: %w", name, namespace, err)
+	}
+	configMapResource := cg.addConfigMapEntity(*configMap)
+	return &configMapResource, nil
+}
+
+func (cg *configMapGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.ConfigMap, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	configMapList, err := cg.client.CoreV1().ConfigMaps(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list configmaps with labels %v in namespace %s: %w", labelSelector, namespace, err)
+	}
+	configMaps := []domain.ConfigMap{}
+	for _, configMap := range configMapList.Items {
+		configMaps = append(configMaps, cg.addConfigMapEntity(configMap))
+	}
+	return configMaps, nil
+}
+
+func (cg *configMapGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	configMap, err := cg.client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap %s in namespace %s: %w", name, namespace, err)
+	}
+	configMap.ManagedFields = nil
+	return yaml.Marshal(configMap)
+}
+
+// PatchValue changes the value of one key with a merge patch, the other keys are
+// untouched. The key must still have the original value, and the patch carries
+// the resourceVersion read so a change in between is refused by the server.
+func (cg *configMapGateway) PatchValue(ctx context.Context, namespace, name, key, original, value string) error {
+	configMap, err := cg.client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get configmap %s in namespace %s: %w", name, namespace, err)
+	}
+	if configMap.Data[key] != original {
+		return fmt.Errorf("key %s of configmap %s in namespace %s: %w", key, name, namespace, domain.ErrConfigMapChanged)
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]string{"resourceVersion": configMap.ResourceVersion},
+		"data":     map[string]string{key: value},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = cg.client.CoreV1().ConfigMaps(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch key %s of configmap %s in namespace %s: %w", key, name, namespace, err)
+	}
+	return nil
+}
+
+// GetReferences returns the pods of the namespace that mount the configmap or
+// read it in their environment
+func (cg *configMapGateway) GetReferences(ctx context.Context, namespace, name string) ([]domain.ConfigMapReference, error) {
+	podList, err := cg.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+	}
+	references := []domain.ConfigMapReference{}
+	for _, pod := range podList.Items {
+		references = append(references, podConfigMapReferences(pod, name)...)
+	}
+	return references, nil
+}
+
+func podConfigMapReferences(pod v1.Pod, name string) []domain.ConfigMapReference {
+	references := []domain.ConfigMapReference{}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.ConfigMap != nil && volume.ConfigMap.Name == name {
+			references = append(references, domain.ConfigMapReference{Pod: pod.Name, Kind: "volume"})
+		}
+		if volume.Projected == nil {
+			continue
+		}
+		for _, source := range volume.Projected.Sources {
+			if source.ConfigMap != nil && source.ConfigMap.Name == name {
+				references = append(references, domain.ConfigMapReference{Pod: pod.Name, Kind: "volume"})
+			}
+		}
+	}
+
+	containers := append(append([]v1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil && envFrom.ConfigMapRef.Name == name {
+				references = append(references, domain.ConfigMapReference{Pod: pod.Name, Container: container.Name, Kind: "envFrom"})
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil && env.ValueFrom.ConfigMapKeyRef.Name == name {
+				references = append(references, domain.ConfigMapReference{
+					Pod:       pod.Name,
+					Container: container.Name,
+					Kind:      "env",
+					Key:       env.ValueFrom.ConfigMapKeyRef.Key,
+				})
+			}
+		}
+	}
+	return references
+}
+
+func (cg *configMapGateway) addConfigMapEntity(configMap v1.ConfigMap) domain.ConfigMap {
+	binaryKeys := []string{}
+	for key := range configMap.BinaryData {
+		binaryKeys = append(binaryKeys, key)
+	}
+	sort.Strings(binaryKeys)
+	return domain.ConfigMap{
+		Name:       configMap.Name,
+		Namespace:  configMap.Namespace,
+		Context:    cg.context,
+		Data:       configMap.Data,
+		BinaryKeys: binaryKeys,
+	}
+}
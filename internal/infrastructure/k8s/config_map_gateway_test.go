@@ -0,0 +1,93 @@
+package k8s_test
+
+import (
+	"context"
+	"errors"
+	"lazykube/internal/domain"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestConfigMapGateway_GetReferences(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "dev"},
+			Spec: v1.PodSpec{
+				Volumes: []v1.Volume{{
+					Name: "config",
+					VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}},
+					},
+				}},
+				Containers: []v1.Container{{
+					Name: "app",
+					Env: []v1.EnvVar{{
+						Name: "LEVEL",
+						ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "settings"},
+							Key:                  "level",
+						}},
+					}},
+				}},
+			},
+		},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "dev"},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:    "db",
+				EnvFrom: []v1.EnvFromSource{{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "other"}}}},
+			}}},
+		},
+	)
+
+	references, err := k8s.NewConfigMapGateway(client, "prod").GetReferences(context.Background(), "dev", "settings")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(references) != 2 {
+		t.Fatalf("expected the volume and the env of web, got %+v", references)
+	}
+	if references[0].Kind != "volume" || references[1].Kind != "env" || references[1].Key != "level" || references[1].Container != "app" {
+		t.Errorf("unexpected references %+v", references)
+	}
+}
+
+func TestConfigMapGateway_PatchValue(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "dev"},
+		Data:       map[string]string{"level": "info", "mode": "fast"},
+	})
+	gateway := k8s.NewConfigMapGateway(client, "prod")
+
+	if err := gateway.PatchValue(context.Background(), "dev", "settings", "level", "info", "debug"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	configMap, err := gateway.GetByName(context.Background(), "dev", "settings")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if configMap.Data["level"] != "debug" || configMap.Data["mode"] != "fast" {
+		t.Errorf("expected only the key changed, got %v", configMap.Data)
+	}
+}
+
+func TestConfigMapGateway_PatchValueChanged(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "dev"},
+		Data:       map[string]string{"level": "warn"},
+	})
+	gateway := k8s.NewConfigMapGateway(client, "prod")
+
+	err := gateway.PatchValue(context.Background(), "dev", "settings", "level", "info", "debug")
+	if !errors.Is(err, domain.ErrConfigMapChanged) {
+		t.Fatalf("expected ErrConfigMapChanged, got %v", err)
+	}
+	configMap, err := gateway.GetByName(context.Background(), "dev", "settings")
+	if err != nil || configMap.Data["level"] != "warn" {
+		t.Errorf("expected the value of the other change kept, got %v %v", configMap, err)
+	}
+}
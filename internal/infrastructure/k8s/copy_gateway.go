@@ -0,0 +1,243 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// CopyGateway copies files between the local filesystem and a container,
+// streaming a tar archive through the exec subresource like kubectl cp does.
+// The container image must have a tar binary.
+type CopyGateway struct {
+	exec *ExecGateway
+}
+
+// NewCopyGateway creates a new CopyGateway.
+func NewCopyGateway(exec *ExecGateway) *CopyGateway {
+	return &CopyGateway{exec: exec}
+}
+
+// CopyFromPod downloads the remote file or directory to localPath.
+// progress is called with the amount of bytes received so far.
+func (cg *CopyGateway) CopyFromPod(ctx context.Context, podName, containerName, namespace, remotePath, localPath string, progress func(int64)) error {
+	remotePath = path.Clean(remotePath)
+	command := []string{"tar", "cf", "-", "-C", path.Dir(remotePath), path.Base(remotePath)}
+
+	reader, writer := io.Pipe()
+	stderr := new(bytes.Buffer)
+	execErr := make(chan error, 1)
+	go func() {
+		err := cg.exec.Execute(ctx, podName, containerName, namespace, command, false, remotecommand.StreamOptions{
+			Stdout: writer,
+			Stderr: stderr,
+		})
+		writer.CloseWithError(err)
+		execErr <- err
+	}()
+
+	// An existing directory receives the remote file or directory inside it
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remotePath))
+	}
+
+	counter := &countingReader{reader: reader, progress: progress}
+	err := extractTar(counter, path.Base(remotePath), localPath)
+	if err == nil {
+		// Drain the padding of the archive so the stream can end
+		_, err = io.Copy(io.Discard, counter)
+	}
+	if err != nil {
+		reader.CloseWithError(err)
+		<-execErr
+		return withStderr(fmt.Errorf("failed to copy %s from pod %s/%s: %w", remotePath, namespace, podName, err), stderr)
+	}
+	if err := <-execErr; err != nil {
+		return withStderr(fmt.Errorf("failed to copy %s from pod %s/%s: %w", remotePath, namespace, podName, err), stderr)
+	}
+	return nil
+}
+
+// CopyToPod uploads the local file or directory to remotePath. Like kubectl cp,
+// a remotePath ending in "/" or that is an existing directory receives it inside.
+// progress is called with the amount of bytes sent so far.
+func (cg *CopyGateway) CopyToPod(ctx context.Context, podName, containerName, namespace, localPath, remotePath string, progress func(int64)) error {
+	if _, err := os.Stat(localPath); err != nil {
+		return fmt.Errorf("failed to read %s: %w", localPath, err)
+	}
+	remoteIsDir := false
+	if !strings.HasSuffix(remotePath, "/") {
+		check := []string{"test", "-d", remotePath}
+		remoteIsDir = cg.exec.Execute(ctx, podName, containerName, namespace, check, false, remotecommand.StreamOptions{
+			Stdout: io.Discard,
+			Stderr: io.Discard,
+		}) == nil
+	}
+	dir, name := uploadDestination(localPath, remotePath, remoteIsDir)
+	command := []string{"tar", "xmf", "-", "-C", dir}
+
+	reader, writer := io.Pipe()
+	go func() {
+		counter := &countingWriter{writer: writer, progress: progress}
+		writer.CloseWithError(writeTar(counter, localPath, name))
+	}()
+
+	stderr := new(bytes.Buffer)
+	err := cg.exec.Execute(ctx, podName, containerName, namespace, command, false, remotecommand.StreamOptions{
+		Stdin:  reader,
+		Stdout: io.Discard,
+		Stderr: stderr,
+	})
+	reader.Close()
+	if err != nil {
+		return withStderr(fmt.Errorf("failed to copy %s to pod %s/%s: %w", localPath, namespace, podName, err), stderr)
+	}
+	return nil
+}
+
+// uploadDestination returns the remote directory the upload is extracted in and
+// the name of its root entry. A directory keeps the name of the local file.
+func uploadDestination(localPath, remotePath string, remoteIsDir bool) (string, string) {
+	if remoteIsDir || strings.HasSuffix(remotePath, "/") {
+		return path.Clean(remotePath), filepath.Base(filepath.Clean(localPath))
+	}
+	remotePath = path.Clean(remotePath)
+	return path.Dir(remotePath), path.Base(remotePath)
+}
+
+// writeTar writes the file or directory in localPath to w, naming its root entry name
+func writeTar(w io.Writer, localPath, name string) error {
+	tw := tar.NewWriter(w)
+	root := filepath.Clean(localPath)
+	err := filepath.Walk(root, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			// Symlinks and devices are not copied
+			return nil
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = path.Join(name, filepath.ToSlash(rel))
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// extractTar writes the entries of the archive under name into dest.
+// Entries that would land outside dest and links are skipped.
+func extractTar(r io.Reader, name, dest string) error {
+	tr := tar.NewReader(r)
+	dest = filepath.Clean(dest)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		entry := path.Clean(header.Name)
+		if entry != name && !strings.HasPrefix(entry, name+"/") {
+			continue
+		}
+		target := filepath.Join(dest, filepath.FromSlash(strings.TrimPrefix(entry, name)))
+		if target != dest && !strings.HasPrefix(target, dest+string(filepath.Separator)) {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode).Perm())
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// withStderr adds the output of the remote tar to the error
+func withStderr(err error, stderr *bytes.Buffer) error {
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		return fmt.Errorf("%w: %s", err, msg)
+	}
+	return err
+}
+
+type countingReader struct {
+	reader   io.Reader
+	total    int64
+	progress func(int64)
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	c.total += int64(n)
+	if c.progress != nil && n > 0 {
+		c.progress(c.total)
+	}
+	return n, err
+}
+
+type countingWriter struct {
+	writer   io.Writer
+	total    int64
+	progress func(int64)
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.writer.Write(p)
+	c.total += int64(n)
+	if c.progress != nil && n > 0 {
+		c.progress(c.total)
+	}
+	return n, err
+}
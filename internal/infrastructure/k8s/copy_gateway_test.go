@@ -0,0 +1,82 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTarRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "conf", "nested"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "conf", "nested", "app.yaml"), []byte("key: value"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := new(bytes.Buffer)
+	if err := writeTar(archive, filepath.Join(src, "conf"), "config"); err != nil {
+		t.Fatalf("writeTar: %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "download")
+	if err := extractTar(archive, "config", dest); err != nil {
+		t.Fatalf("extractTar: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dest, "nested", "app.yaml"))
+	if err != nil {
+		t.Fatalf("expected the file in the destination: %v", err)
+	}
+	if string(data) != "key: value" {
+		t.Errorf("unexpected content %q", data)
+	}
+}
+
+func TestExtractTarSkipsEntriesOutsideDestination(t *testing.T) {
+	archive := new(bytes.Buffer)
+	tw := tar.NewWriter(archive)
+	content := []byte("evil")
+	if err := tw.WriteHeader(&tar.Header{Name: "dump/../../evil", Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	tw.Close()
+
+	root := t.TempDir()
+	dest := filepath.Join(root, "out", "dump")
+	if err := extractTar(archive, "dump", dest); err != nil {
+		t.Fatalf("extractTar: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "evil")); !os.IsNotExist(err) {
+		t.Errorf("the entry escaped the destination")
+	}
+}
+
+func TestUploadDestination(t *testing.T) {
+	local := filepath.Join("home", "me", "dump.sql")
+	tests := []struct {
+		remote      string
+		remoteIsDir bool
+		dir, name   string
+	}{
+		{"/tmp/backup.sql", false, "/tmp", "backup.sql"},
+		{"/tmp/", false, "/tmp", "dump.sql"},
+		{"/tmp", true, "/tmp", "dump.sql"},
+		{"/", false, "/", "dump.sql"},
+		{"data/out", false, "data", "out"},
+	}
+	for _, test := range tests {
+		dir, name := uploadDestination(local, test.remote, test.remoteIsDir)
+		if dir != test.dir || name != test.name {
+			t.Errorf("expected %s %s for %s, got %s %s", test.dir, test.name, test.remote, dir, name)
+		}
+	}
+	if _, name := uploadDestination(filepath.Join("home", "me", "conf")+string(filepath.Separator), "/etc/", false); name != "conf" {
+		t.Errorf("expected the name of the local directory, got %s", name)
+	}
+}
@@ -0,0 +1,148 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// manualJobSuffix follows the name of the cronjob in a triggered job, with a random part
+	manualJobSuffix = "-manual-"
+	manualJobRandom = 5
+	// maxJobNamePrefix keeps the name of a triggered job within the 63 characters of a label value
+	maxJobNamePrefix = 63 - len(manualJobSuffix) - manualJobRandom
+)
+
+type cronJobGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewCronJobGateway return a cronJobGateway struct
+func NewCronJobGateway(client kubernetes.Interface, cluster string) port.CronJobResourceGateway {
+	return &cronJobGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (cg *cronJobGateway) GetAll(ctx context.Context, namespace string) ([]domain.CronJob, error) {
+	cronJobList, err := cg.client.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs in namespace %s: %w", namespace, err)
+	}
+	cronJobs := []domain.CronJob{}
+	for _, cronJob := range cronJobList.Items {
+		cronJobs = append(cronJobs, cg.addCronJobEntity(cronJob))
+	}
+	return cronJobs, nil
+}
+
+func (cg *cronJobGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.CronJob, error) {
+	cronJob, err := cg.client.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cronjob %s in namespace %s: %w", name, namespace, err)
+	}
+	cronJobResource := cg.addCronJobEntity(*cronJob)
+	return &cronJobResource, nil
+}
+
+func (cg *cronJobGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.CronJob, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	cronJobList, err := cg.client.BatchV1().CronJobs(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs with labels %v in namespace %s: %w", labelSelector, namespace, err)
+	}
+	cronJobs := []domain.CronJob{}
+	for _, cronJob := range cronJobList.Items {
+		cronJobs = append(cronJobs, cg.addCronJobEntity(cronJob))
+	}
+	return cronJobs, nil
+}
+
+func (cg *cronJobGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	cronJob, err := cg.client.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cronjob %s in namespace %s: %w", name, namespace, err)
+	}
+	cronJob.ManagedFields = nil
+	return yaml.Marshal(cronJob)
+}
+
+func (cg *cronJobGateway) SetSuspend(ctx context.Context, namespace, name string, suspend bool) error {
+	patch, err := json.Marshal(map[string]any{"spec": map[string]bool{"suspend": suspend}})
+	if err != nil {
+		return err
+	}
+	_, err = cg.client.BatchV1().CronJobs(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch cronjob %s in namespace %s: %w", name, namespace, err)
+	}
+	return nil
+}
+
+// Trigger creates a job from the template like kubectl create job --from=cronjob/name,
+// the cronjob owns it so its history limits apply
+func (cg *cronJobGateway) Trigger(ctx context.Context, namespace, name string) (string, error) {
+	cronJob, err := cg.client.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get cronjob %s in namespace %s: %w", name, namespace, err)
+	}
+
+	prefix := name
+	if len(prefix) > maxJobNamePrefix {
+		prefix = prefix[:maxJobNamePrefix]
+	}
+	annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+	for key, value := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[key] = value
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        prefix + manualJobSuffix + rand.String(manualJobRandom),
+			Namespace:   namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+	created, err := cg.client.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to create a job from cronjob %s: %w", name, err)
+	}
+	return created.Name, nil
+}
+
+func (cg *cronJobGateway) addCronJobEntity(cronJob batchv1.CronJob) domain.CronJob {
+	entity := domain.CronJob{
+		Name:      cronJob.Name,
+		Namespace: cronJob.Namespace,
+		Context:   cg.context,
+		Schedule:  cronJob.Spec.Schedule,
+		Suspend:   cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+		Active:    len(cronJob.Status.Active),
+	}
+	if cronJob.Spec.TimeZone != nil {
+		entity.TimeZone = *cronJob.Spec.TimeZone
+	}
+	if cronJob.Status.LastScheduleTime != nil {
+		entity.LastSchedule = cronJob.Status.LastScheduleTime.Time
+	}
+	return entity
+}
@@ -0,0 +1,90 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCronJobGateway_Trigger(t *testing.T) {
+	client := fake.NewSimpleClientset(&batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "dev", UID: "uid-1"},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "backup"}},
+				Spec:       batchv1.JobSpec{BackoffLimit: new(int32)},
+			},
+		},
+	})
+	gateway := k8s.NewCronJobGateway(client, "prod")
+
+	name, err := gateway.Trigger(context.Background(), "dev", "backup")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(name, "backup-manual-") {
+		t.Errorf("unexpected job name %s", name)
+	}
+	job, err := client.BatchV1().Jobs("dev").Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the job created, got %v", err)
+	}
+	if job.Labels["app"] != "backup" || job.Annotations["cronjob.kubernetes.io/instantiate"] != "manual" {
+		t.Errorf("expected the metadata of the template, got %v %v", job.Labels, job.Annotations)
+	}
+	owner := metav1.GetControllerOf(job)
+	if owner == nil || owner.Kind != "CronJob" || owner.Name != "backup" {
+		t.Errorf("expected the cronjob as owner, got %+v", owner)
+	}
+
+	jobs, err := k8s.NewJobGateway(client, "prod").GetAll(context.Background(), "dev")
+	if err != nil || len(jobs) != 1 || jobs[0].CronJob != "backup" || jobs[0].Status != "Running" {
+		t.Errorf("expected the running job of the cronjob, got %+v %v", jobs, err)
+	}
+}
+
+func TestCronJobGateway_TriggerLongName(t *testing.T) {
+	cronJobName := strings.Repeat("a", 52)
+	client := fake.NewSimpleClientset(&batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: cronJobName, Namespace: "dev", UID: "uid-1"},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 * * * *"},
+	})
+	gateway := k8s.NewCronJobGateway(client, "prod")
+
+	name, err := gateway.Trigger(context.Background(), "dev", cronJobName)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(name) != 63 {
+		t.Errorf("expected a job name of 63 characters, got %d: %s", len(name), name)
+	}
+	if !strings.HasPrefix(name, cronJobName[:50]+"-manual-") {
+		t.Errorf("unexpected job name %s", name)
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		t.Errorf("expected a valid label value, got %v", errs)
+	}
+}
+
+func TestCronJobGateway_SetSuspend(t *testing.T) {
+	client := fake.NewSimpleClientset(&batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "dev"},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 * * * *"},
+	})
+	gateway := k8s.NewCronJobGateway(client, "prod")
+
+	if err := gateway.SetSuspend(context.Background(), "dev", "backup", true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	cronJob, err := gateway.GetByName(context.Background(), "dev", "backup")
+	if err != nil || !cronJob.Suspend {
+		t.Errorf("expected the cronjob suspended, got %+v %v", cronJob, err)
+	}
+}
@@ -0,0 +1,107 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type daemonSetGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewDaemonSetGateway return a daemonSetGateway struct
+func NewDaemonSetGateway(client kubernetes.Interface, cluster string) port.DaemonSetResourceGateway {
+	return &daemonSetGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (dg *daemonSetGateway) GetAll(ctx context.Context, namespace string) ([]domain.DaemonSet, error) {
+	daemonSetList, err := dg.client.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets in namespace %s: %w", namespace, err)
+	}
+	daemonSets := []domain.DaemonSet{}
+	for _, daemonSet := range daemonSetList.Items {
+		daemonSets = append(daemonSets, dg.addDaemonSetEntity(daemonSet))
+	}
+	return daemonSets, nil
+}
+
+func (dg *daemonSetGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.DaemonSet, error) {
+	daemonSet, err := dg.client.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daemonset %s in namespace %s: %w", name, namespace, err)
+	}
+	daemonSetResource := dg.addDaemonSetEntity(*daemonSet)
+	return &daemonSetResource, nil
+}
+
+func (dg *daemonSetGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.DaemonSet, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	daemonSetList, err := dg.client.AppsV1().DaemonSets(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets with labels %v in namespace %s: %w", labelSelector, namespace, err)
+	}
+	daemonSets := []domain.DaemonSet{}
+	for _, daemonSet := range daemonSetList.Items {
+		daemonSets = append(daemonSets, dg.addDaemonSetEntity(daemonSet))
+	}
+	return daemonSets, nil
+}
+
+func (dg *daemonSetGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	daemonSet, err := dg.client.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daemonset %s in namespace %s: %w", name, namespace, err)
+	}
+	daemonSet.ManagedFields = nil
+	return yaml.Marshal(daemonSet)
+}
+
+// GetPods returns the pods controlled by the daemonset
+func (dg *daemonSetGateway) GetPods(ctx context.Context, daemonSetName, namespace string) ([]domain.Pod, error) {
+	daemonSet, err := dg.client.AppsV1().DaemonSets(namespace).Get(ctx, daemonSetName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daemonset %s in namespace %s: %w", daemonSetName, namespace, err)
+	}
+	pods, err := ownedPods(ctx, dg.client, dg.context, namespace, daemonSet.Spec.Selector, map[types.UID]bool{daemonSet.UID: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for daemonset %s: %w", daemonSetName, err)
+	}
+	return pods, nil
+}
+
+func (dg *daemonSetGateway) Restart(ctx context.Context, namespace, name string) error {
+	_, err := dg.client.AppsV1().DaemonSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, restartPatch(metav1.Now()), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to restart daemonset %s in namespace %s: %w", name, namespace, err)
+	}
+	return nil
+}
+
+func (dg *daemonSetGateway) addDaemonSetEntity(daemonSet appsv1.DaemonSet) domain.DaemonSet {
+	return domain.DaemonSet{
+		Name:      daemonSet.Name,
+		Namespace: daemonSet.Namespace,
+		Context:   dg.context,
+		Desired:   daemonSet.Status.DesiredNumberScheduled,
+		Current:   daemonSet.Status.CurrentNumberScheduled,
+		Ready:     daemonSet.Status.NumberReady,
+		UpToDate:  daemonSet.Status.UpdatedNumberScheduled,
+		Available: daemonSet.Status.NumberAvailable,
+	}
+}
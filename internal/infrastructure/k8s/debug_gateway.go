@@ -0,0 +1,77 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// DebugGateway adds ephemeral debug containers to the pods.
+type DebugGateway struct {
+	client kubernetes.Interface
+}
+
+// NewDebugGateway creates a new DebugGateway.
+func NewDebugGateway(client kubernetes.Interface) *DebugGateway {
+	return &DebugGateway{client: client}
+}
+
+// AddDebugContainer adds an ephemeral container with the image through the
+// pods/ephemeralcontainers subresource and waits until it is running.
+// When targetContainer isn't empty the debug container shares its process namespace.
+func (dg *DebugGateway) AddDebugContainer(ctx context.Context, podName, namespace, image, targetContainer string) (string, error) {
+	name := "debugger-" + utilrand.String(5)
+	// The pod is read again when it changed in between, like while it is updated
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pod, err := dg.client.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get pod %s/%s: %w", namespace, podName, err)
+		}
+		pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, v1.EphemeralContainer{
+			EphemeralContainerCommon: v1.EphemeralContainerCommon{
+				Name:                     name,
+				Image:                    image,
+				ImagePullPolicy:          v1.PullIfNotPresent,
+				Stdin:                    true,
+				TTY:                      true,
+				TerminationMessagePolicy: v1.TerminationMessageReadFile,
+			},
+			TargetContainerName: targetContainer,
+		})
+		if _, err := dg.client.CoreV1().Pods(namespace).UpdateEphemeralContainers(ctx, podName, pod, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to add debug container to pod %s/%s: %w", namespace, podName, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = wait.PollUntilContextTimeout(ctx, time.Second, 2*time.Minute, true, func(ctx context.Context) (bool, error) {
+		pod, err := dg.client.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, status := range pod.Status.EphemeralContainerStatuses {
+			if status.Name != name {
+				continue
+			}
+			if status.State.Terminated != nil {
+				return false, fmt.Errorf("debug container %s terminated: %s", name, status.State.Terminated.Reason)
+			}
+			return status.State.Running != nil, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("debug container %s is not running: %w", name, err)
+	}
+	return name, nil
+}
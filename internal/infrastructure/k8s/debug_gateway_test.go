@@ -0,0 +1,64 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestDebugGateway_AddDebugContainerRetriesConflicts(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "dev"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "app"}}},
+	})
+	updates := 0
+	client.PrependReactor("update", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "ephemeralcontainers" {
+			return false, nil, nil
+		}
+		updates++
+		if updates == 1 {
+			return true, nil, apierrors.NewConflict(v1.Resource("pods"), "web-1", nil)
+		}
+		// The kubelet starts the container once the pod is updated
+		pod := action.(k8stesting.UpdateAction).GetObject().(*v1.Pod).DeepCopy()
+		for _, container := range pod.Spec.EphemeralContainers {
+			pod.Status.EphemeralContainerStatuses = append(pod.Status.EphemeralContainerStatuses, v1.ContainerStatus{
+				Name:  container.Name,
+				State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+			})
+		}
+		if err := client.Tracker().Update(v1.SchemeGroupVersion.WithResource("pods"), pod, "dev"); err != nil {
+			return true, nil, err
+		}
+		return true, pod, nil
+	})
+	gateway := k8s.NewDebugGateway(client)
+
+	name, err := gateway.AddDebugContainer(context.Background(), "web-1", "dev", "busybox", "app")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updates != 2 {
+		t.Errorf("expected the update retried after the conflict, got %d updates", updates)
+	}
+
+	pod, err := client.CoreV1().Pods("dev").Get(context.Background(), "web-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pod.Spec.EphemeralContainers) != 1 {
+		t.Fatalf("expected 1 ephemeral container, got %+v", pod.Spec.EphemeralContainers)
+	}
+	container := pod.Spec.EphemeralContainers[0]
+	if container.Name != name || container.Image != "busybox" || container.TargetContainerName != "app" || !container.TTY {
+		t.Errorf("unexpected ephemeral container %+v", container)
+	}
+}
@@ -7,9 +7,9 @@ import (
 	"lazykube/internal/usecase/port"
 
 	appsv1 "k8s.io/api/apps/v1"
-	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	yaml "sigs.k8s.io/yaml"
@@ -30,6 +30,7 @@ func NewDeploymentGateway(client kubernetes.Interface, config *rest.Config, clus
 	}
 }
 
+// GetPods returns the pods of the replicasets controlled by the deployment
 func (pg *deploymentGateway) GetPods(ctx context.Context, deploymentName, namespace string) ([]domain.Pod, error) {
 	deployment, err := pg.client.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
 	if err != nil {
@@ -37,39 +38,22 @@ func (pg *deploymentGateway) GetPods(ctx context.Context, deploymentName, namesp
 	}
 
 	selector := labels.Set(deployment.Spec.Selector.MatchLabels).String()
-	podList, err := pg.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	replicaSetList, err := pg.client.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list pods for deployment %s: %w", deploymentName, err)
-	}
-
-	podResources := []domain.Pod{}
-	for _, pod := range podList.Items {
-		podResource := pg.addPodtoEntity(pod)
-		podResources = append(podResources, podResource)
+		return nil, fmt.Errorf("failed to list replicasets for deployment %s: %w", deploymentName, err)
 	}
-
-	return podResources, nil
-}
-
-func (pg *deploymentGateway) addPodtoEntity(pod v1.Pod) domain.Pod {
-	statuses := []domain.ContainerStatuses{}
-	for _, status := range pod.Status.ContainerStatuses {
-		statusResource := domain.ContainerStatuses{
-			Name:         status.Name,
-			State:        status.State.String(),
-			RestartCount: status.RestartCount,
-			Image:        status.Image,
+	owners := map[types.UID]bool{}
+	for _, replicaSet := range replicaSetList.Items {
+		if owner := metav1.GetControllerOf(&replicaSet); owner != nil && owner.UID == deployment.UID {
+			owners[replicaSet.UID] = true
 		}
-		statuses = append(statuses, statusResource)
 	}
-	podResource := domain.Pod{
-		Name:              pod.Name,
-		Namespace:         pod.Namespace,
-		Context:           pg.context,
-		State:             string(pod.Status.Phase),
-		ContainerStatuses: statuses,
+
+	pods, err := ownedPods(ctx, pg.client, pg.context, namespace, deployment.Spec.Selector, owners)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for deployment %s: %w", deploymentName, err)
 	}
-	return podResource
+	return pods, nil
 }
 
 func (pg *deploymentGateway) GetAll(ctx context.Context, namespace string) ([]domain.Deployment, error) {
@@ -5,15 +5,17 @@ import (
 	"fmt"
 	"lazykube/internal/domain"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 )
 
 // IExecGateway defines the interface for executing commands in a pod.
 type IExecGateway interface {
-	Execute(ctx context.Context, podName, containerName, namespace, command string, options remotecommand.StreamOptions) error
+	Execute(ctx context.Context, podName, containerName, namespace string, command []string, dryRun bool, options remotecommand.StreamOptions) error
 }
 
 // ExecGateway implements the IExecGateway interface.
@@ -31,7 +33,8 @@ func NewExecGateway(clientset *kubernetes.Clientset, config *rest.Config) *ExecG
 }
 
 // Execute establishes a SPDY connection to a pod and executes a command.
-func (g *ExecGateway) Execute(ctx context.Context, podName, containerName, namespace, command string, dryRun bool, options remotecommand.StreamOptions) error {
+// Every element of command is sent as a separate argument.
+func (g *ExecGateway) Execute(ctx context.Context, podName, containerName, namespace string, command []string, dryRun bool, options remotecommand.StreamOptions) error {
 	pod, err := g.clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get pod %s/%s: %w", namespace, podName, err)
@@ -60,13 +63,17 @@ func (g *ExecGateway) Execute(ctx context.Context, podName, containerName, names
 		Name(podName).
 		Namespace(namespace).
 		SubResource("exec").
-		Param("container", containerName).
-		Param("command", command).
-		Param("stdin", "true").
-		Param("stdout", "true").
-		Param("stderr", "true").
-		Param("tty", "true").
-		Param("env", "TERM=xterm-256color")
+		VersionedParams(&v1.PodExecOptions{
+			Container: containerName,
+			Command:   command,
+			Stdin:     options.Stdin != nil,
+			Stdout:    options.Stdout != nil,
+			Stderr:    options.Stderr != nil,
+			TTY:       options.Tty,
+		}, scheme.ParameterCodec)
+	if options.Tty {
+		req = req.Param("env", "TERM=xterm-256color")
+	}
 
 	executor, err := remotecommand.NewSPDYExecutor(g.config, "POST", req.URL())
 	if err != nil {
@@ -0,0 +1,54 @@
+package k8s
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+)
+
+type healthGateway struct {
+	discovery discovery.ServerVersionInterface
+	context   string
+}
+
+// NewHealthGateway return a healthGateway, the timeout of the checks is the
+// timeout of the discovery client
+func NewHealthGateway(discovery discovery.ServerVersionInterface, cluster string) port.HealthGateway {
+	return &healthGateway{
+		discovery: discovery,
+		context:   cluster,
+	}
+}
+
+func (hg *healthGateway) Check(ctx context.Context) domain.ClusterHealth {
+	health := domain.ClusterHealth{Context: hg.context, CheckedAt: time.Now()}
+	start := time.Now()
+	info, err := hg.discovery.ServerVersion()
+	health.Latency = time.Since(start)
+	if err != nil {
+		health.Status = healthStatus(err)
+		health.Error = err.Error()
+		return health
+	}
+	health.Status = domain.ClusterHealthy
+	health.Version = info.GitVersion
+	return health
+}
+
+// healthStatus tells the auth problems, like an expired token or a failing
+// exec plugin, from the connectivity ones
+func healthStatus(err error) string {
+	if apierrors.IsUnauthorized(err) {
+		return domain.ClusterUnauthorized
+	}
+	message := err.Error()
+	if strings.Contains(message, "getting credentials") || strings.Contains(message, "exec plugin") {
+		return domain.ClusterUnauthorized
+	}
+	return domain.ClusterUnreachable
+}
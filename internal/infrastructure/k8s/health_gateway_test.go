@@ -0,0 +1,45 @@
+package k8s_test
+
+import (
+	"context"
+	"errors"
+	"lazykube/internal/domain"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestHealthGateway_Check(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.33.1"}
+
+	health := k8s.NewHealthGateway(client.Discovery(), "prod").Check(context.Background())
+	if health.Status != domain.ClusterHealthy || health.Version != "v1.33.1" || health.Context != "prod" {
+		t.Errorf("unexpected health %+v", health)
+	}
+
+	tests := []struct {
+		err    error
+		status string
+	}{
+		{apierrors.NewUnauthorized("token expired"), domain.ClusterUnauthorized},
+		{errors.New(`getting credentials: exec: executable aws not found`), domain.ClusterUnauthorized},
+		{errors.New("dial tcp 10.0.0.1:443: i/o timeout"), domain.ClusterUnreachable},
+	}
+	for _, tt := range tests {
+		client := fake.NewSimpleClientset()
+		client.PrependReactor("get", "version", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, tt.err
+		})
+		health := k8s.NewHealthGateway(client.Discovery(), "prod").Check(context.Background())
+		if health.Status != tt.status || health.Error == "" {
+			t.Errorf("expected %s for %v, got %+v", tt.status, tt.err, health)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type horizontalPodAutoscalerGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewHorizontalPodAutoscalerGateway return a horizontalPodAutoscalerGateway struct
+func NewHorizontalPodAutoscalerGateway(client kubernetes.Interface, cluster string) port.HorizontalPodAutoscalerResourceGateway {
+	return &horizontalPodAutoscalerGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (hg *horizontalPodAutoscalerGateway) GetAll(ctx context.Context, namespace string) ([]domain.HorizontalPodAutoscaler, error) {
+	return hg.GetByLabels(ctx, namespace, nil)
+}
+
+func (hg *horizontalPodAutoscalerGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.HorizontalPodAutoscaler, error) {
+	autoscaler, err := hg.client.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get horizontalpodautoscaler %s in namespace %s: %w", name, namespace, err)
+	}
+	autoscalerResource := hg.addAutoscalerEntity(*autoscaler)
+	return &autoscalerResource, nil
+}
+
+func (hg *horizontalPodAutoscalerGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.HorizontalPodAutoscaler, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	autoscalerList, err := hg.client.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list horizontalpodautoscalers in namespace %s: %w", namespace, err)
+	}
+	autoscalers := []domain.HorizontalPodAutoscaler{}
+	for _, autoscaler := range autoscalerList.Items {
+		autoscalers = append(autoscalers, hg.addAutoscalerEntity(autoscaler))
+	}
+	return autoscalers, nil
+}
+
+func (hg *horizontalPodAutoscalerGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	autoscaler, err := hg.client.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get horizontalpodautoscaler %s in namespace %s: %w", name, namespace, err)
+	}
+	autoscaler.ManagedFields = nil
+	return yaml.Marshal(autoscaler)
+}
+
+// SetReplicas patches the bounds of the replicas of the autoscaler
+func (hg *horizontalPodAutoscalerGateway) SetReplicas(ctx context.Context, namespace, name string, minReplicas, maxReplicas int32) error {
+	patch := fmt.Appendf(nil, `{"spec":{"minReplicas":%d,"maxReplicas":%d}}`, minReplicas, maxReplicas)
+	_, err := hg.client.AutoscalingV2().HorizontalPodAutoscalers(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch horizontalpodautoscaler %s in namespace %s: %w", name, namespace, err)
+	}
+	return nil
+}
+
+func (hg *horizontalPodAutoscalerGateway) addAutoscalerEntity(autoscaler autoscalingv2.HorizontalPodAutoscaler) domain.HorizontalPodAutoscaler {
+	minReplicas := int32(1)
+	if autoscaler.Spec.MinReplicas != nil {
+		minReplicas = *autoscaler.Spec.MinReplicas
+	}
+	metrics := []domain.AutoscalerMetric{}
+	for _, spec := range autoscaler.Spec.Metrics {
+		name, target := metricSpec(spec)
+		current := "<unknown>"
+		for _, status := range autoscaler.Status.CurrentMetrics {
+			if statusName, value := metricStatus(status); statusName == name {
+				current = value
+			}
+		}
+		metrics = append(metrics, domain.AutoscalerMetric{Name: name, Current: current, Target: target})
+	}
+	conditions := []domain.AutoscalerCondition{}
+	for _, condition := range autoscaler.Status.Conditions {
+		conditions = append(conditions, domain.AutoscalerCondition{
+			Type:    string(condition.Type),
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+	return domain.HorizontalPodAutoscaler{
+		Name:            autoscaler.Name,
+		Namespace:       autoscaler.Namespace,
+		Context:         hg.context,
+		TargetKind:      autoscaler.Spec.ScaleTargetRef.Kind,
+		TargetName:      autoscaler.Spec.ScaleTargetRef.Name,
+		MinReplicas:     minReplicas,
+		MaxReplicas:     autoscaler.Spec.MaxReplicas,
+		CurrentReplicas: autoscaler.Status.CurrentReplicas,
+		DesiredReplicas: autoscaler.Status.DesiredReplicas,
+		Metrics:         metrics,
+		Conditions:      conditions,
+	}
+}
+
+// metricSpec returns the name of the metric, like resource/cpu, and its target
+func metricSpec(spec autoscalingv2.MetricSpec) (string, string) {
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if spec.Resource != nil {
+			return "resource/" + string(spec.Resource.Name), metricTarget(spec.Resource.Target)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if spec.ContainerResource != nil {
+			return "resource/" + string(spec.ContainerResource.Name) + " of " + spec.ContainerResource.Container, metricTarget(spec.ContainerResource.Target)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if spec.Pods != nil {
+			return "pods/" + spec.Pods.Metric.Name, metricTarget(spec.Pods.Target)
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if spec.Object != nil {
+			return "object/" + spec.Object.Metric.Name, metricTarget(spec.Object.Target)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if spec.External != nil {
+			return "external/" + spec.External.Metric.Name, metricTarget(spec.External.Target)
+		}
+	}
+	return strings.ToLower(string(spec.Type)), "<unknown>"
+}
+
+// metricStatus returns the name of the metric like metricSpec, and its current value
+func metricStatus(status autoscalingv2.MetricStatus) (string, string) {
+	switch status.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if status.Resource != nil {
+			return "resource/" + string(status.Resource.Name), metricValue(status.Resource.Current)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if status.ContainerResource != nil {
+			return "resource/" + string(status.ContainerResource.Name) + " of " + status.ContainerResource.Container, metricValue(status.ContainerResource.Current)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if status.Pods != nil {
+			return "pods/" + status.Pods.Metric.Name, metricValue(status.Pods.Current)
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if status.Object != nil {
+			return "object/" + status.Object.Metric.Name, metricValue(status.Object.Current)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if status.External != nil {
+			return "external/" + status.External.Metric.Name, metricValue(status.External.Current)
+		}
+	}
+	return strings.ToLower(string(status.Type)), "<unknown>"
+}
+
+func metricTarget(target autoscalingv2.MetricTarget) string {
+	switch {
+	case target.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *target.AverageUtilization)
+	case target.AverageValue != nil:
+		return target.AverageValue.String()
+	case target.Value != nil:
+		return target.Value.String()
+	}
+	return "<unknown>"
+}
+
+func metricValue(value autoscalingv2.MetricValueStatus) string {
+	switch {
+	case value.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *value.AverageUtilization)
+	case value.AverageValue != nil:
+		return value.AverageValue.String()
+	case value.Value != nil:
+		return value.Value.String()
+	}
+	return "<unknown>"
+}
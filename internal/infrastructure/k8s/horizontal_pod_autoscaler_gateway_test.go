@@ -0,0 +1,87 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestHorizontalPodAutoscalerGateway(t *testing.T) {
+	client := fake.NewSimpleClientset(&autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "dev"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web"},
+			MinReplicas:    ptr.To[int32](2),
+			MaxReplicas:    10,
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   v1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: ptr.To[int32](70)},
+					},
+				},
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: ptr.To(resource.MustParse("100"))},
+					},
+				},
+			},
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 3,
+			DesiredReplicas: 4,
+			CurrentMetrics: []autoscalingv2.MetricStatus{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricStatus{
+					Name:    v1.ResourceCPU,
+					Current: autoscalingv2.MetricValueStatus{AverageUtilization: ptr.To[int32](85)},
+				},
+			}},
+			Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+				{Type: autoscalingv2.ScalingLimited, Status: v1.ConditionTrue, Reason: "TooManyReplicas"},
+			},
+		},
+	})
+	gateway := k8s.NewHorizontalPodAutoscalerGateway(client, "prod")
+
+	autoscaler, err := gateway.GetByName(context.Background(), "dev", "web")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if autoscaler.TargetKind != "Deployment" || autoscaler.TargetName != "web" || autoscaler.MinReplicas != 2 || autoscaler.MaxReplicas != 10 {
+		t.Errorf("unexpected autoscaler %+v", autoscaler)
+	}
+	if len(autoscaler.Metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %+v", autoscaler.Metrics)
+	}
+	if cpu := autoscaler.Metrics[0]; cpu.Name != "resource/cpu" || cpu.Current != "85%" || cpu.Target != "70%" {
+		t.Errorf("unexpected cpu metric %+v", cpu)
+	}
+	if rps := autoscaler.Metrics[1]; rps.Name != "pods/requests_per_second" || rps.Current != "<unknown>" || rps.Target != "100" {
+		t.Errorf("unexpected pods metric %+v", rps)
+	}
+	if len(autoscaler.Conditions) != 1 || autoscaler.Conditions[0].Reason != "TooManyReplicas" {
+		t.Errorf("unexpected conditions %+v", autoscaler.Conditions)
+	}
+
+	if err := gateway.SetReplicas(context.Background(), "dev", "web", 3, 12); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	patched, err := client.AutoscalingV2().HorizontalPodAutoscalers("dev").Get(context.Background(), "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *patched.Spec.MinReplicas != 3 || patched.Spec.MaxReplicas != 12 {
+		t.Errorf("expected the replicas 3 to 12, got %d to %d", *patched.Spec.MinReplicas, patched.Spec.MaxReplicas)
+	}
+}
@@ -0,0 +1,203 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+// httpRouteResource is the Gateway API HTTPRoute, read with the dynamic client
+// since its types are not part of client-go
+var httpRouteResource = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+
+// gatewayResource is the Gateway API Gateway, its listeners hold the certificates of the routes
+var gatewayResource = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways"}
+
+type httpRouteGateway struct {
+	client  dynamic.Interface
+	context string
+}
+
+// NewHTTPRouteGateway return a httpRouteGateway struct
+func NewHTTPRouteGateway(client dynamic.Interface, cluster string) port.IngressResourceGateway {
+	return &httpRouteGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (hg *httpRouteGateway) GetAll(ctx context.Context, namespace string) ([]domain.Ingress, error) {
+	return hg.list(ctx, namespace, metav1.ListOptions{})
+}
+
+func (hg *httpRouteGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Ingress, error) {
+	route, err := hg.client.Resource(httpRouteResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get httproute %s in namespace %s: %w", name, namespace, err)
+	}
+	routeResource := hg.addHTTPRouteEntity(*route)
+	routeResource.TLS = hg.parentTLS(ctx, *route)
+	return &routeResource, nil
+}
+
+func (hg *httpRouteGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.Ingress, error) {
+	return hg.list(ctx, namespace, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	})
+}
+
+func (hg *httpRouteGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	route, err := hg.client.Resource(httpRouteResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get httproute %s in namespace %s: %w", name, namespace, err)
+	}
+	route.SetManagedFields(nil)
+	return yaml.Marshal(route.Object)
+}
+
+// list returns no routes when the cluster doesn't serve the Gateway API
+func (hg *httpRouteGateway) list(ctx context.Context, namespace string, options metav1.ListOptions) ([]domain.Ingress, error) {
+	routeList, err := hg.client.Resource(httpRouteResource).Namespace(namespace).List(ctx, options)
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return []domain.Ingress{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list httproutes in namespace %s: %w", namespace, err)
+	}
+	routes := []domain.Ingress{}
+	for _, route := range routeList.Items {
+		routes = append(routes, hg.addHTTPRouteEntity(route))
+	}
+	return routes, nil
+}
+
+func (hg *httpRouteGateway) addHTTPRouteEntity(route unstructured.Unstructured) domain.Ingress {
+	hosts, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	if len(hosts) == 0 {
+		hosts = []string{"*"}
+	}
+	parents := []string{}
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	for _, parentRef := range parentRefs {
+		ref, _ := parentRef.(map[string]any)
+		name, _, _ := unstructured.NestedString(ref, "name")
+		if namespace, _, _ := unstructured.NestedString(ref, "namespace"); namespace != "" {
+			name = namespace + "/" + name
+		}
+		parents = append(parents, name)
+	}
+	routes := []domain.IngressRoute{}
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+	for _, r := range rules {
+		rule, _ := r.(map[string]any)
+		paths := []string{}
+		matches, _, _ := unstructured.NestedSlice(rule, "matches")
+		for _, m := range matches {
+			match, _ := m.(map[string]any)
+			if path, ok, _ := unstructured.NestedString(match, "path", "value"); ok {
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) == 0 {
+			paths = []string{"/"}
+		}
+		backendRefs, _, _ := unstructured.NestedSlice(rule, "backendRefs")
+		for _, b := range backendRefs {
+			backend, _ := b.(map[string]any)
+			if kind, ok, _ := unstructured.NestedString(backend, "kind"); ok && kind != "Service" {
+				continue
+			}
+			service, _, _ := unstructured.NestedString(backend, "name")
+			namespace, _, _ := unstructured.NestedString(backend, "namespace")
+			if namespace == "" {
+				namespace = route.GetNamespace()
+			}
+			port := ""
+			if number, ok, _ := unstructured.NestedInt64(backend, "port"); ok {
+				port = strconv.FormatInt(number, 10)
+			}
+			for _, host := range hosts {
+				for _, path := range paths {
+					routes = append(routes, domain.IngressRoute{
+						Host:      host,
+						Path:      path,
+						Service:   service,
+						Namespace: namespace,
+						Port:      port,
+					})
+				}
+			}
+		}
+	}
+	return domain.Ingress{
+		Name:      route.GetName(),
+		Namespace: route.GetNamespace(),
+		Context:   hg.context,
+		Parents:   parents,
+		Hosts:     hosts,
+		Routes:    routes,
+	}
+}
+
+// parentTLS returns the certificates of the TLS listeners of the parent gateways
+// of the route, a gateway that can't be read is skipped
+func (hg *httpRouteGateway) parentTLS(ctx context.Context, route unstructured.Unstructured) []domain.IngressTLS {
+	tls := []domain.IngressTLS{}
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	for _, parentRef := range parentRefs {
+		ref, _ := parentRef.(map[string]any)
+		if kind, ok, _ := unstructured.NestedString(ref, "kind"); ok && kind != "Gateway" {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(ref, "name")
+		namespace, _, _ := unstructured.NestedString(ref, "namespace")
+		if namespace == "" {
+			namespace = route.GetNamespace()
+		}
+		sectionName, _, _ := unstructured.NestedString(ref, "sectionName")
+		gateway, err := hg.client.Resource(gatewayResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			continue
+		}
+		listeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+		for _, l := range listeners {
+			listener, _ := l.(map[string]any)
+			if listenerName, _, _ := unstructured.NestedString(listener, "name"); sectionName != "" && listenerName != sectionName {
+				continue
+			}
+			host, _, _ := unstructured.NestedString(listener, "hostname")
+			if host == "" {
+				host = "*"
+			}
+			certificateRefs, _, _ := unstructured.NestedSlice(listener, "tls", "certificateRefs")
+			for _, c := range certificateRefs {
+				certificateRef, _ := c.(map[string]any)
+				if kind, ok, _ := unstructured.NestedString(certificateRef, "kind"); ok && kind != "Secret" {
+					continue
+				}
+				secret, _, _ := unstructured.NestedString(certificateRef, "name")
+				secretNamespace, _, _ := unstructured.NestedString(certificateRef, "namespace")
+				if secretNamespace == "" {
+					secretNamespace = namespace
+				}
+				tls = append(tls, domain.IngressTLS{
+					Hosts:     []string{host},
+					Secret:    secret,
+					Namespace: secretNamespace,
+				})
+			}
+		}
+	}
+	return tls
+}
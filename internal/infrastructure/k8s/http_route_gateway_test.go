@@ -0,0 +1,127 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var httpRoutes = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+
+func TestHTTPRouteGateway_GetAll(t *testing.T) {
+	route := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "HTTPRoute",
+		"metadata":   map[string]any{"name": "web", "namespace": "dev"},
+		"spec": map[string]any{
+			"parentRefs": []any{map[string]any{"name": "public", "namespace": "infra"}},
+			"hostnames":  []any{"example.com"},
+			"rules": []any{map[string]any{
+				"matches":     []any{map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/api"}}},
+				"backendRefs": []any{map[string]any{"name": "api", "port": int64(8080)}},
+			}},
+		},
+	}}
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{httpRoutes: "HTTPRouteList"}, route)
+	gateway := k8s.NewHTTPRouteGateway(client, "prod")
+
+	routes, err := gateway.GetAll(context.Background(), "dev")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(routes) != 1 {
+		t.Fatalf("expected 1 httproute, got %+v", routes)
+	}
+	if len(routes[0].Parents) != 1 || routes[0].Parents[0] != "infra/public" {
+		t.Errorf("unexpected parents %v", routes[0].Parents)
+	}
+	if len(routes[0].Routes) != 1 {
+		t.Fatalf("expected 1 route, got %+v", routes[0].Routes)
+	}
+	got := routes[0].Routes[0]
+	if got.Host != "example.com" || got.Path != "/api" || got.Service != "api" || got.Namespace != "dev" || got.Port != "8080" {
+		t.Errorf("unexpected route %+v", got)
+	}
+}
+
+func TestHTTPRouteGateway_GetAllWithoutGatewayAPI(t *testing.T) {
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{httpRoutes: "HTTPRouteList"})
+	client.PrependReactor("list", "httproutes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(httpRoutes.GroupResource(), "")
+	})
+	gateway := k8s.NewHTTPRouteGateway(client, "prod")
+
+	routes, err := gateway.GetAll(context.Background(), "dev")
+	if err != nil {
+		t.Fatalf("expected no error when the api is not served, got %v", err)
+	}
+	if len(routes) != 0 {
+		t.Errorf("expected no httproutes, got %+v", routes)
+	}
+}
+
+func TestHTTPRouteGateway_GetByNameResolvesParentTLS(t *testing.T) {
+	route := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "HTTPRoute",
+		"metadata":   map[string]any{"name": "web", "namespace": "dev"},
+		"spec": map[string]any{
+			"parentRefs": []any{
+				map[string]any{"name": "public", "namespace": "infra", "sectionName": "https"},
+				map[string]any{"name": "missing"},
+			},
+			"hostnames": []any{"example.com"},
+		},
+	}}
+	gateway := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "Gateway",
+		"metadata":   map[string]any{"name": "public", "namespace": "infra"},
+		"spec": map[string]any{
+			"listeners": []any{
+				map[string]any{"name": "http", "port": int64(80), "protocol": "HTTP"},
+				map[string]any{
+					"name": "https", "port": int64(443), "protocol": "HTTPS", "hostname": "*.example.com",
+					"tls": map[string]any{"certificateRefs": []any{
+						map[string]any{"name": "wildcard"},
+						map[string]any{"name": "shared", "namespace": "certs"},
+					}},
+				},
+				map[string]any{
+					"name": "other", "port": int64(8443), "protocol": "HTTPS",
+					"tls": map[string]any{"certificateRefs": []any{map[string]any{"name": "other"}}},
+				},
+			},
+		},
+	}}
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{httpRoutes: "HTTPRouteList"}, route)
+	// the fake client guesses "gatewaies" as the resource of the Gateway kind
+	gateways := schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "gateways"}
+	if err := client.Tracker().Create(gateways, gateway, "infra"); err != nil {
+		t.Fatalf("failed to create the gateway: %v", err)
+	}
+
+	got, err := k8s.NewHTTPRouteGateway(client, "prod").GetByName(context.Background(), "dev", "web")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got.TLS) != 2 {
+		t.Fatalf("expected the 2 certificates of the https listener, got %+v", got.TLS)
+	}
+	if got.TLS[0].Secret != "wildcard" || got.TLS[0].Namespace != "infra" || got.TLS[0].Hosts[0] != "*.example.com" {
+		t.Errorf("unexpected tls %+v", got.TLS[0])
+	}
+	if got.TLS[1].Secret != "shared" || got.TLS[1].Namespace != "certs" {
+		t.Errorf("unexpected tls %+v", got.TLS[1])
+	}
+}
@@ -0,0 +1,139 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"strconv"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type ingressGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewIngressGateway return a ingressGateway struct
+func NewIngressGateway(client kubernetes.Interface, cluster string) port.IngressResourceGateway {
+	return &ingressGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (ig *ingressGateway) GetAll(ctx context.Context, namespace string) ([]domain.Ingress, error) {
+	ingressList, err := ig.client.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingresses in namespace %s: %w", namespace, err)
+	}
+	ingresses := []domain.Ingress{}
+	for _, ingress := range ingressList.Items {
+		ingresses = append(ingresses, ig.addIngressEntity(ingress))
+	}
+	return ingresses, nil
+}
+
+func (ig *ingressGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Ingress, error) {
+	ingress, err := ig.client.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingress %s in namespace %s: %w", name, namespace, err)
+	}
+	ingressResource := ig.addIngressEntity(*ingress)
+	return &ingressResource, nil
+}
+
+func (ig *ingressGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.Ingress, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	ingressList, err := ig.client.NetworkingV1().Ingresses(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingresses with labels %v in namespace %s: %w", labelSelector, namespace, err)
+	}
+	ingresses := []domain.Ingress{}
+	for _, ingress := range ingressList.Items {
+		ingresses = append(ingresses, ig.addIngressEntity(ingress))
+	}
+	return ingresses, nil
+}
+
+func (ig *ingressGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	ingress, err := ig.client.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingress %s in namespace %s: %w", name, namespace, err)
+	}
+	ingress.ManagedFields = nil
+	return yaml.Marshal(ingress)
+}
+
+func (ig *ingressGateway) addIngressEntity(ingress networkingv1.Ingress) domain.Ingress {
+	class := ""
+	if ingress.Spec.IngressClassName != nil {
+		class = *ingress.Spec.IngressClassName
+	} else if annotation, ok := ingress.Annotations["kubernetes.io/ingress.class"]; ok {
+		class = annotation
+	}
+	routes := []domain.IngressRoute{}
+	if backend := ingress.Spec.DefaultBackend; backend != nil && backend.Service != nil {
+		routes = append(routes, ingressRoute("*", "(default)", ingress.Namespace, *backend.Service))
+	}
+	hosts := []string{}
+	for _, rule := range ingress.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		hosts = append(hosts, host)
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service == nil {
+				continue
+			}
+			routes = append(routes, ingressRoute(host, path.Path, ingress.Namespace, *path.Backend.Service))
+		}
+	}
+	tls := []domain.IngressTLS{}
+	for _, ingressTLS := range ingress.Spec.TLS {
+		tls = append(tls, domain.IngressTLS{Hosts: ingressTLS.Hosts, Secret: ingressTLS.SecretName})
+	}
+	address := []string{}
+	for _, loadBalancer := range ingress.Status.LoadBalancer.Ingress {
+		if loadBalancer.IP != "" {
+			address = append(address, loadBalancer.IP)
+		} else if loadBalancer.Hostname != "" {
+			address = append(address, loadBalancer.Hostname)
+		}
+	}
+	return domain.Ingress{
+		Name:      ingress.Name,
+		Namespace: ingress.Namespace,
+		Context:   ig.context,
+		Class:     class,
+		Hosts:     hosts,
+		Address:   address,
+		Routes:    routes,
+		TLS:       tls,
+	}
+}
+
+func ingressRoute(host, path, namespace string, backend networkingv1.IngressServiceBackend) domain.IngressRoute {
+	port := backend.Port.Name
+	if port == "" {
+		port = strconv.Itoa(int(backend.Port.Number))
+	}
+	return domain.IngressRoute{
+		Host:      host,
+		Path:      path,
+		Service:   backend.Name,
+		Namespace: namespace,
+		Port:      port,
+	}
+}
@@ -0,0 +1,59 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestIngressGateway_GetByName(t *testing.T) {
+	client := fake.NewSimpleClientset(&networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web",
+			Namespace:   "dev",
+			Annotations: map[string]string{"kubernetes.io/ingress.class": "nginx"},
+		},
+		Spec: networkingv1.IngressSpec{
+			DefaultBackend: &networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+				Name: "fallback", Port: networkingv1.ServiceBackendPort{Number: 80},
+			}},
+			TLS: []networkingv1.IngressTLS{{Hosts: []string{"example.com"}, SecretName: "web-tls"}},
+			Rules: []networkingv1.IngressRule{{
+				Host: "example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path: "/api",
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: "api", Port: networkingv1.ServiceBackendPort{Name: "http"},
+						}},
+					}},
+				}},
+			}},
+		},
+	})
+	gateway := k8s.NewIngressGateway(client, "prod")
+
+	ingress, err := gateway.GetByName(context.Background(), "dev", "web")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ingress.Class != "nginx" {
+		t.Errorf("expected the class from the annotation, got %q", ingress.Class)
+	}
+	if len(ingress.Routes) != 2 {
+		t.Fatalf("expected the default backend and the rule, got %+v", ingress.Routes)
+	}
+	if route := ingress.Routes[0]; route.Host != "*" || route.Service != "fallback" || route.Port != "80" {
+		t.Errorf("unexpected default route %+v", route)
+	}
+	if route := ingress.Routes[1]; route.Host != "example.com" || route.Path != "/api" || route.Service != "api" || route.Port != "http" || route.Namespace != "dev" {
+		t.Errorf("unexpected route %+v", route)
+	}
+	if len(ingress.TLS) != 1 || ingress.TLS[0].Secret != "web-tls" {
+		t.Errorf("unexpected tls %+v", ingress.TLS)
+	}
+}
@@ -0,0 +1,143 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type jobGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewJobGateway return a jobGateway struct
+func NewJobGateway(client kubernetes.Interface, cluster string) port.JobResourceGateway {
+	return &jobGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (jg *jobGateway) GetAll(ctx context.Context, namespace string) ([]domain.Job, error) {
+	jobList, err := jg.client.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs in namespace %s: %w", namespace, err)
+	}
+	jobs := []domain.Job{}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, jg.addJobEntity(job))
+	}
+	return jobs, nil
+}
+
+func (jg *jobGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Job, error) {
+	job, err := jg.client.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job %s in namespace %s: %w", name, namespace, err)
+	}
+	jobResource := jg.addJobEntity(*job)
+	return &jobResource, nil
+}
+
+func (jg *jobGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.Job, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	jobList, err := jg.client.BatchV1().Jobs(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs with labels %v in namespace %s: %w", labelSelector, namespace, err)
+	}
+	jobs := []domain.Job{}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, jg.addJobEntity(job))
+	}
+	return jobs, nil
+}
+
+func (jg *jobGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	job, err := jg.client.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job %s in namespace %s: %w", name, namespace, err)
+	}
+	job.ManagedFields = nil
+	return yaml.Marshal(job)
+}
+
+// GetPods returns the pods created by the job, selected by the selector the controller set
+func (jg *jobGateway) GetPods(ctx context.Context, jobName, namespace string) ([]domain.Pod, error) {
+	job, err := jg.client.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job %s in namespace %s: %w", jobName, namespace, err)
+	}
+	selector := labels.Set{"job-name": jobName}.String()
+	if job.Spec.Selector != nil {
+		jobSelector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of job %s: %w", jobName, err)
+		}
+		selector = jobSelector.String()
+	}
+	podList, err := jg.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for job %s: %w", jobName, err)
+	}
+	pods := []domain.Pod{}
+	for _, pod := range podList.Items {
+		pods = append(pods, newPodEntity(pod, jg.context))
+	}
+	return pods, nil
+}
+
+func (jg *jobGateway) addJobEntity(job batchv1.Job) domain.Job {
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	entity := domain.Job{
+		Name:        job.Name,
+		Namespace:   job.Namespace,
+		Context:     jg.context,
+		Completions: completions,
+		Succeeded:   job.Status.Succeeded,
+		Active:      job.Status.Active,
+		Failed:      job.Status.Failed,
+		Status:      jobStatus(job),
+	}
+	if owner := metav1.GetControllerOf(&job); owner != nil && owner.Kind == "CronJob" {
+		entity.CronJob = owner.Name
+	}
+	if job.Status.StartTime != nil {
+		entity.StartTime = job.Status.StartTime.Time
+	}
+	if job.Status.CompletionTime != nil {
+		entity.CompletionTime = job.Status.CompletionTime.Time
+	}
+	return entity
+}
+
+func jobStatus(job batchv1.Job) string {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return domain.JobComplete
+		case batchv1.JobFailed:
+			return domain.JobFailed
+		}
+	}
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return domain.JobSuspended
+	}
+	return domain.JobRunning
+}
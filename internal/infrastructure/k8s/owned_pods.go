@@ -0,0 +1,41 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ownedPods returns the pods of the selector whose controller is one of the owners.
+// The selector only narrows the listing, other workloads can share the labels.
+func ownedPods(ctx context.Context, client kubernetes.Interface, cluster, namespace string, selector *metav1.LabelSelector, owners map[types.UID]bool) ([]domain.Pod, error) {
+	options := metav1.ListOptions{}
+	if selector != nil {
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector: %w", err)
+		}
+		options.LabelSelector = labelSelector.String()
+	}
+	podList, err := client.CoreV1().Pods(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+	}
+	pods := []domain.Pod{}
+	for _, pod := range podList.Items {
+		if owner := metav1.GetControllerOf(&pod); owner != nil && owners[owner.UID] {
+			pods = append(pods, newPodEntity(pod, cluster))
+		}
+	}
+	return pods, nil
+}
+
+// restartPatch changes an annotation of the pod template like kubectl rollout restart,
+// so the controller replaces every pod
+func restartPatch(now metav1.Time) []byte {
+	return fmt.Appendf(nil, `{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`, now.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,62 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func ownedBy(kind, name string, uid types.UID) []metav1.OwnerReference {
+	controller := true
+	return []metav1.OwnerReference{{Kind: kind, Name: name, UID: uid, Controller: &controller}}
+}
+
+func TestStatefulSetGateway_GetPodsByOwner(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}
+	labels := map[string]string{"app": "db"}
+	client := fake.NewSimpleClientset(
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "dev", UID: "sts-uid"},
+			Spec:       appsv1.StatefulSetSpec{Selector: selector},
+		},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: "dev", Labels: labels, OwnerReferences: ownedBy("StatefulSet", "db", "sts-uid")}},
+		// Same labels, other controller
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db-backup", Namespace: "dev", Labels: labels, OwnerReferences: ownedBy("Job", "backup", "job-uid")}},
+	)
+
+	pods, err := k8s.NewStatefulSetGateway(client, "prod").GetPods(context.Background(), "db", "dev")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "db-0" || pods[0].Context != "prod" {
+		t.Errorf("expected only the pod of the statefulset, got %+v", pods)
+	}
+}
+
+func TestDeploymentGateway_GetPodsThroughReplicaSets(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+	labels := map[string]string{"app": "web"}
+	client := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "dev", UID: "deploy-uid"},
+			Spec:       appsv1.DeploymentSpec{Selector: selector},
+		},
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "dev", UID: "rs-uid", Labels: labels, OwnerReferences: ownedBy("Deployment", "web", "deploy-uid")}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1-a", Namespace: "dev", Labels: labels, OwnerReferences: ownedBy("ReplicaSet", "web-1", "rs-uid")}},
+		&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-debug", Namespace: "dev", Labels: labels}},
+	)
+
+	pods, err := k8s.NewDeploymentGateway(client, nil, "prod").GetPods(context.Background(), "web", "dev")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(pods) != 1 || pods[0].Name != "web-1-a" {
+		t.Errorf("expected only the pod of the replicaset, got %+v", pods)
+	}
+}
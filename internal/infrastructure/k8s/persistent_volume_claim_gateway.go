@@ -0,0 +1,176 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type persistentVolumeClaimGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewPersistentVolumeClaimGateway return a persistentVolumeClaimGateway struct
+func NewPersistentVolumeClaimGateway(client kubernetes.Interface, cluster string) port.PersistentVolumeClaimResourceGateway {
+	return &persistentVolumeClaimGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (pg *persistentVolumeClaimGateway) GetAll(ctx context.Context, namespace string) ([]domain.PersistentVolumeClaim, error) {
+	return pg.list(ctx, namespace, metav1.ListOptions{})
+}
+
+func (pg *persistentVolumeClaimGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.PersistentVolumeClaim, error) {
+	claim, err := pg.client.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get persistentvolumeclaim %s in namespace %s: %w", name, namespace, err)
+	}
+	claims := pg.addClaimEntities(ctx, namespace, []v1.PersistentVolumeClaim{*claim})
+	return &claims[0], nil
+}
+
+func (pg *persistentVolumeClaimGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.PersistentVolumeClaim, error) {
+	return pg.list(ctx, namespace, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	})
+}
+
+func (pg *persistentVolumeClaimGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	claim, err := pg.client.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get persistentvolumeclaim %s in namespace %s: %w", name, namespace, err)
+	}
+	claim.ManagedFields = nil
+	return yaml.Marshal(claim)
+}
+
+func (pg *persistentVolumeClaimGateway) list(ctx context.Context, namespace string, options metav1.ListOptions) ([]domain.PersistentVolumeClaim, error) {
+	claimList, err := pg.client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumeclaims in namespace %s: %w", namespace, err)
+	}
+	return pg.addClaimEntities(ctx, namespace, claimList.Items), nil
+}
+
+// addClaimEntities converts the claims with the pods that mount them and the reason
+// of the pending ones. Both are left out when the pods or the events can't be listed.
+func (pg *persistentVolumeClaimGateway) addClaimEntities(ctx context.Context, namespace string, claimList []v1.PersistentVolumeClaim) []domain.PersistentVolumeClaim {
+	mounts := map[string][]string{}
+	if podList, err := pg.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{}); err == nil {
+		for _, pod := range podList.Items {
+			for _, volume := range pod.Spec.Volumes {
+				if volume.PersistentVolumeClaim != nil {
+					key := pod.Namespace + "/" + volume.PersistentVolumeClaim.ClaimName
+					mounts[key] = append(mounts[key], pod.Name)
+				}
+			}
+		}
+	}
+
+	reasons := map[string]string{}
+	for _, claim := range claimList {
+		if claim.Status.Phase == v1.ClaimPending {
+			reasons = pg.pendingReasons(ctx, namespace)
+			break
+		}
+	}
+
+	claims := []domain.PersistentVolumeClaim{}
+	for _, claim := range claimList {
+		key := claim.Namespace + "/" + claim.Name
+		capacity := claim.Status.Capacity[v1.ResourceStorage]
+		if capacity.IsZero() {
+			capacity = claim.Spec.Resources.Requests[v1.ResourceStorage]
+		}
+		storageClass := ""
+		if claim.Spec.StorageClassName != nil {
+			storageClass = *claim.Spec.StorageClassName
+		}
+		modes := claim.Status.AccessModes
+		if len(modes) == 0 {
+			modes = claim.Spec.AccessModes
+		}
+		domainClaim := domain.PersistentVolumeClaim{
+			Name:         claim.Name,
+			Namespace:    claim.Namespace,
+			Context:      pg.context,
+			Status:       string(claim.Status.Phase),
+			Volume:       claim.Spec.VolumeName,
+			Capacity:     capacity.String(),
+			StorageClass: storageClass,
+			AccessModes:  accessModes(modes),
+			MountedBy:    mounts[key],
+		}
+		if claim.Status.Phase == v1.ClaimPending {
+			domainClaim.Reason = reasons[key]
+		}
+		claims = append(claims, domainClaim)
+	}
+	return claims
+}
+
+// pendingReasons returns the message of the latest event of every claim
+func (pg *persistentVolumeClaimGateway) pendingReasons(ctx context.Context, namespace string) map[string]string {
+	reasons := map[string]string{}
+	options := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.kind", "PersistentVolumeClaim").String(),
+	}
+	eventList, err := pg.client.CoreV1().Events(namespace).List(ctx, options)
+	if err != nil {
+		return reasons
+	}
+	events := eventList.Items
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(events[i]).Time.Before(eventTime(events[j]).Time)
+	})
+	for _, event := range events {
+		if event.InvolvedObject.Kind != "PersistentVolumeClaim" {
+			continue
+		}
+		key := event.InvolvedObject.Namespace + "/" + event.InvolvedObject.Name
+		reasons[key] = event.Reason + ": " + event.Message
+	}
+	return reasons
+}
+
+// eventTime returns when the event last happened, the new events only have an event time
+func eventTime(event v1.Event) metav1.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp
+	case !event.EventTime.IsZero():
+		return metav1.NewTime(event.EventTime.Time)
+	}
+	return event.CreationTimestamp
+}
+
+// accessModes returns the access modes with the abbreviations of kubectl
+func accessModes(modes []v1.PersistentVolumeAccessMode) []string {
+	abbreviations := map[v1.PersistentVolumeAccessMode]string{
+		v1.ReadWriteOnce:    "RWO",
+		v1.ReadOnlyMany:     "ROX",
+		v1.ReadWriteMany:    "RWX",
+		v1.ReadWriteOncePod: "RWOP",
+	}
+	result := []string{}
+	for _, mode := range modes {
+		if abbreviation, ok := abbreviations[mode]; ok {
+			result = append(result, abbreviation)
+		} else {
+			result = append(result, string(mode))
+		}
+	}
+	return result
+}
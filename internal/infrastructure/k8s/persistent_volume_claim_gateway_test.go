@@ -0,0 +1,81 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPersistentVolumeClaimGateway_GetAll(t *testing.T) {
+	now := time.Now()
+	claimEvent := func(name, reason string, at time.Time) *v1.Event {
+		return &v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "dev"},
+			InvolvedObject: v1.ObjectReference{Kind: "PersistentVolumeClaim", Namespace: "dev", Name: "data-db-0"},
+			Reason:         reason,
+			Message:        reason + " message",
+			LastTimestamp:  metav1.NewTime(at),
+		}
+	}
+	client := fake.NewSimpleClientset(
+		&v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-db-0", Namespace: "dev"},
+			Spec: v1.PersistentVolumeClaimSpec{
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				Resources: v1.VolumeResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("10Gi")},
+				},
+			},
+			Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+		},
+		&v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "logs", Namespace: "dev"},
+			Spec:       v1.PersistentVolumeClaimSpec{VolumeName: "pv-1"},
+			Status: v1.PersistentVolumeClaimStatus{
+				Phase:       v1.ClaimBound,
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+				Capacity:    v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "dev"},
+			Spec: v1.PodSpec{Volumes: []v1.Volume{{
+				Name:         "logs",
+				VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "logs"}},
+			}}},
+		},
+		claimEvent("new", "ProvisioningFailed", now),
+		claimEvent("old", "WaitForFirstConsumer", now.Add(-time.Hour)),
+	)
+	gateway := k8s.NewPersistentVolumeClaimGateway(client, "prod")
+
+	claims, err := gateway.GetAll(context.Background(), "dev")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(claims) != 2 {
+		t.Fatalf("expected 2 claims, got %+v", claims)
+	}
+	pending, bound := claims[0], claims[1]
+	if pending.Name != "data-db-0" {
+		pending, bound = bound, pending
+	}
+	if pending.Reason != "ProvisioningFailed: ProvisioningFailed message" {
+		t.Errorf("expected the reason of the latest event, got %q", pending.Reason)
+	}
+	if pending.Capacity != "10Gi" || len(pending.AccessModes) != 1 || pending.AccessModes[0] != "RWO" {
+		t.Errorf("expected the request of the pending claim, got %+v", pending)
+	}
+	if bound.Reason != "" || bound.Capacity != "1Gi" || bound.Volume != "pv-1" || bound.AccessModes[0] != "RWX" {
+		t.Errorf("unexpected bound claim %+v", bound)
+	}
+	if len(bound.MountedBy) != 1 || bound.MountedBy[0] != "web-1" {
+		t.Errorf("expected the claim mounted by web-1, got %v", bound.MountedBy)
+	}
+}
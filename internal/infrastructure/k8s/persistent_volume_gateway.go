@@ -0,0 +1,87 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type persistentVolumeGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewPersistentVolumeGateway return a persistentVolumeGateway struct
+func NewPersistentVolumeGateway(client kubernetes.Interface, cluster string) port.PersistentVolumeResourceGateway {
+	return &persistentVolumeGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (pg *persistentVolumeGateway) GetAll(ctx context.Context, namespace string) ([]domain.PersistentVolume, error) {
+	return pg.GetByLabels(ctx, namespace, nil)
+}
+
+func (pg *persistentVolumeGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.PersistentVolume, error) {
+	volume, err := pg.client.CoreV1().PersistentVolumes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get persistentvolume %s: %w", name, err)
+	}
+	volumeResource := pg.addVolumeEntity(*volume)
+	return &volumeResource, nil
+}
+
+func (pg *persistentVolumeGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.PersistentVolume, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	volumeList, err := pg.client.CoreV1().PersistentVolumes().List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumes: %w", err)
+	}
+	volumes := []domain.PersistentVolume{}
+	for _, volume := range volumeList.Items {
+		volumes = append(volumes, pg.addVolumeEntity(volume))
+	}
+	return volumes, nil
+}
+
+func (pg *persistentVolumeGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	volume, err := pg.client.CoreV1().PersistentVolumes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get persistentvolume %s: %w", name, err)
+	}
+	volume.ManagedFields = nil
+	return yaml.Marshal(volume)
+}
+
+func (pg *persistentVolumeGateway) addVolumeEntity(volume v1.PersistentVolume) domain.PersistentVolume {
+	claim := ""
+	if ref := volume.Spec.ClaimRef; ref != nil {
+		claim = ref.Namespace + "/" + ref.Name
+	}
+	capacity := volume.Spec.Capacity[v1.ResourceStorage]
+	reason := volume.Status.Reason
+	if volume.Status.Message != "" {
+		reason += ": " + volume.Status.Message
+	}
+	return domain.PersistentVolume{
+		Name:          volume.Name,
+		Context:       pg.context,
+		Status:        string(volume.Status.Phase),
+		Claim:         claim,
+		Capacity:      capacity.String(),
+		StorageClass:  volume.Spec.StorageClassName,
+		AccessModes:   accessModes(volume.Spec.AccessModes),
+		ReclaimPolicy: string(volume.Spec.PersistentVolumeReclaimPolicy),
+		Reason:        reason,
+	}
+}
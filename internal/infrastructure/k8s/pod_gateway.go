@@ -1,7 +1,6 @@
 package k8s
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -38,7 +37,7 @@ func (pg *podGateway) GetLogs(ctx context.Context, podName, namespace, container
 	return logGateway.GetLogs(ctx, podName, namespace, containerName)
 }
 
-func (pg *podGateway) Exec(ctx context.Context, podName, namespace, command, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
+func (pg *podGateway) Exec(ctx context.Context, podName, namespace string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
 	clientset, ok := pg.client.(*kubernetes.Clientset)
 	if !ok {
 		return fmt.Errorf("failed to cast client to *kubernetes.Clientset")
@@ -48,6 +47,31 @@ func (pg *podGateway) Exec(ctx context.Context, podName, namespace, command, con
 	return execGateway.Execute(ctx, podName, containerName, namespace, command, dryRun, options)
 }
 
+func (pg *podGateway) CopyFrom(ctx context.Context, podName, namespace, containerName, remotePath, localPath string, progress func(int64)) error {
+	clientset, ok := pg.client.(*kubernetes.Clientset)
+	if !ok {
+		return fmt.Errorf("failed to cast client to *kubernetes.Clientset")
+	}
+
+	copyGateway := NewCopyGateway(NewExecGateway(clientset, pg.config))
+	return copyGateway.CopyFromPod(ctx, podName, containerName, namespace, remotePath, localPath, progress)
+}
+
+func (pg *podGateway) CopyTo(ctx context.Context, podName, namespace, containerName, localPath, remotePath string, progress func(int64)) error {
+	clientset, ok := pg.client.(*kubernetes.Clientset)
+	if !ok {
+		return fmt.Errorf("failed to cast client to *kubernetes.Clientset")
+	}
+
+	copyGateway := NewCopyGateway(NewExecGateway(clientset, pg.config))
+	return copyGateway.CopyToPod(ctx, podName, containerName, namespace, localPath, remotePath, progress)
+}
+
+func (pg *podGateway) Debug(ctx context.Context, podName, namespace, image, targetContainer string) (string, error) {
+	debugGateway := NewDebugGateway(pg.client)
+	return debugGateway.AddDebugContainer(ctx, podName, namespace, image, targetContainer)
+}
+
 func (pg *podGateway) GetAll(ctx context.Context, namespace string) ([]domain.Pod, error) {
 	podList, err := pg.client.CoreV1().Pods(namespace).
 		List(ctx, metav1.ListOptions{})
@@ -90,7 +114,7 @@ func (pg *podGateway) GetYaml(ctx context.Context, namespace string, name string
 }
 
 func (pg *podGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Pod, error) {
-	pod, err := pg.client.CoreV1().Pods("").Get(ctx, name, metav1.GetOptions{})
+	pod, err := pg.client.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pod %s: %w", name, err)
 	}
@@ -98,12 +122,17 @@ func (pg *podGateway) GetByName(ctx context.Context, namespace string, name stri
 	return &podResource, nil
 }
 
-func (pg *podGateway) PortForward(namespace, podName string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error) {
-	portForwardGateway := NewPortForwardGateway(pg.config)
-	return portForwardGateway.Forward(namespace, podName, ports, stopChan, readyChan)
+func (pg *podGateway) PortForward(ctx context.Context, namespace, podName string, ports []string, out, errOut io.Writer, ready func([]domain.ForwardedPort)) error {
+	portForwardGateway := NewPortForwardGateway(pg.client, pg.config)
+	return portForwardGateway.Forward(ctx, namespace, podName, ports, out, errOut, ready)
 }
 
 func (pg *podGateway) addPodtoEntity(pod v1.Pod) domain.Pod {
+	return newPodEntity(pod, pg.context)
+}
+
+// newPodEntity converts the pod of the api to the domain, it's shared by the gateways that return pods
+func newPodEntity(pod v1.Pod, cluster string) domain.Pod {
 	statuses := []domain.ContainerStatuses{}
 	for _, status := range pod.Status.ContainerStatuses {
 		statusResource := domain.ContainerStatuses{
@@ -114,11 +143,36 @@ func (pg *podGateway) addPodtoEntity(pod v1.Pod) domain.Pod {
 		}
 		statuses = append(statuses, statusResource)
 	}
+	containers := []domain.Container{}
+	for _, container := range pod.Spec.Containers {
+		ports := []domain.ContainerPort{}
+		for _, port := range container.Ports {
+			ports = append(ports, domain.ContainerPort{
+				Name:          port.Name,
+				ContainerPort: port.ContainerPort,
+				Protocol:      string(port.Protocol),
+			})
+		}
+		containers = append(containers, domain.Container{
+			Name:  container.Name,
+			Image: container.Image,
+			Ports: ports,
+		})
+	}
+	ready := false
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			ready = condition.Status == v1.ConditionTrue
+		}
+	}
 	podResource := domain.Pod{
 		Name:              pod.Name,
 		Namespace:         pod.Namespace,
-		Context:           pg.context,
+		Context:           cluster,
 		State:             string(pod.Status.Phase),
+		Ready:             ready,
+		Labels:            pod.Labels,
+		Containers:        containers,
 		ContainerStatuses: statuses,
 	}
 	return podResource
@@ -1,12 +1,15 @@
 package k8s
 
 import (
-	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"lazykube/internal/domain"
 	"net/http"
-	"net/url"
-	"strings"
+	"sync"
 
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
@@ -14,56 +17,79 @@ import (
 
 // PortForwardGateway handles port forwarding to a pod.
 type PortForwardGateway struct {
+	client kubernetes.Interface
 	config *rest.Config
 }
 
 // NewPortForwardGateway creates a new PortForwardGateway.
-func NewPortForwardGateway(config *rest.Config) *PortForwardGateway {
+func NewPortForwardGateway(client kubernetes.Interface, config *rest.Config) *PortForwardGateway {
 	return &PortForwardGateway{
+		client: client,
 		config: config,
 	}
 }
 
 // Forward establishes a port forwarding connection to a pod.
-// It runs until the stopChan is closed.
-func (g *PortForwardGateway) Forward(namespace, podName string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error) {
-	// Build the URL for the port forward request
-	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/portforward", namespace, podName)
-	hostIP := strings.TrimLeft(g.config.Host, "htps:/")
-	serverURL, err := url.Parse(fmt.Sprintf("https://%s%s", hostIP, path))
-	if err != nil {
-		return nil, nil, fmt.Errorf("error parsing URL: %w", err)
-	}
+// It blocks until the ctx is cancelled or the connection is lost, ready is called
+// with the local ports once they are listening.
+func (g *PortForwardGateway) Forward(ctx context.Context, namespace, podName string, ports []string, out, errOut io.Writer, ready func([]domain.ForwardedPort)) error {
+	// The REST client keeps the scheme, the proxy and the path prefix of the api server
+	serverURL := g.client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("portforward").
+		URL()
 
 	// Create a SPDY transport
 	transport, upgrader, err := spdy.RoundTripperFor(g.config)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error creating round tripper: %w", err)
+		return fmt.Errorf("error creating round tripper: %w", err)
 	}
 
 	// Create the dialer
 	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, serverURL)
 
-	// Create streams for output
-	stdout := new(bytes.Buffer)
-	stderr := new(bytes.Buffer)
+	stopChan := make(chan struct{})
+	readyChan := make(chan struct{})
 
 	// Create the port forwarder
-	forwarder, err := portforward.New(dialer, ports, stopChan, readyChan, stdout, stderr)
+	forwarder, err := portforward.New(dialer, ports, stopChan, readyChan, out, errOut)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error creating port forwarder: %w", err)
+		return fmt.Errorf("error creating port forwarder: %w", err)
 	}
 
-	// Run the port forwarder in a goroutine
-	go func() {
-		// This will block until the stopChan is closed or an error occurs.
-		if err = forwarder.ForwardPorts(); err != nil {
-			// It's common for this to error out when the connection is closed,
-			// so we might not want to log this as a fatal error.
-			// For now, we can just print it to stderr buffer.
-			fmt.Fprintf(stderr, "Error forwarding ports: %v\n", err)
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	wg.Go(func() {
+		select {
+		case <-ctx.Done():
+			close(stopChan)
+		case <-done:
+		}
+	})
+	wg.Go(func() {
+		select {
+		case <-readyChan:
+			forwarded, _ := forwarder.GetPorts()
+			bound := []domain.ForwardedPort{}
+			for _, port := range forwarded {
+				bound = append(bound, domain.ForwardedPort{Local: port.Local, Remote: port.Remote})
+			}
+			ready(bound)
+		case <-done:
 		}
-	}()
+	})
 
-	return stdout, stderr, nil
+	// This will block until the stopChan is closed or an error occurs.
+	err = forwarder.ForwardPorts()
+	close(done)
+	wg.Wait()
+	if errors.Is(err, portforward.ErrLostConnectionToPod) {
+		return domain.ErrPortForwardLost
+	}
+	if err != nil {
+		return fmt.Errorf("error forwarding ports: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,113 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// lastAppliedAnnotation keeps a copy of the values written by kubectl apply
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+type secretGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewSecretGateway return a secretGateway struct
+func NewSecretGateway(client kubernetes.Interface, cluster string) port.SecretResourceGateway {
+	return &secretGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (sg *secretGateway) GetAll(ctx context.Context, namespace string) ([]domain.Secret, error) {
+	secretList, err := sg.client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets in namespace %s: %w", namespace, err)
+	}
+	secrets := []domain.Secret{}
+	for _, secret := range secretList.Items {
+		secrets = append(secrets, sg.addSecretEntity(secret))
+	}
+	return secrets, nil
+}
+
+func (sg *secretGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Secret, error) {
+	secret, err := sg.client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s in namespace %s: %w", name, namespace, err)
+	}
+	secretResource := sg.addSecretEntity(*secret)
+	return &secretResource, nil
+}
+
+func (sg *secretGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.Secret, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	secretList, err := sg.client.CoreV1().Secrets(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets with labels %v in namespace %s: %w", labelSelector, namespace, err)
+	}
+	secrets := []domain.Secret{}
+	for _, secret := range secretList.Items {
+		secrets = append(secrets, sg.addSecretEntity(secret))
+	}
+	return secrets, nil
+}
+
+// GetYaml returns the secret with every value replaced by its size
+func (sg *secretGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	secret, err := sg.client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s in namespace %s: %w", name, namespace, err)
+	}
+	secret.ManagedFields = nil
+	delete(secret.Annotations, lastAppliedAnnotation)
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(secret)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret.Data) > 0 {
+		masked := map[string]any{}
+		for key, value := range secret.Data {
+			masked[key] = fmt.Sprintf("****** (%d bytes)", len(value))
+		}
+		object["data"] = masked
+	}
+	return yaml.Marshal(object)
+}
+
+func (sg *secretGateway) GetData(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	secret, err := sg.client.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s in namespace %s: %w", name, namespace, err)
+	}
+	return secret.Data, nil
+}
+
+func (sg *secretGateway) addSecretEntity(secret v1.Secret) domain.Secret {
+	keys := []domain.SecretKey{}
+	for key, value := range secret.Data {
+		keys = append(keys, domain.SecretKey{Name: key, Size: len(value)})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	return domain.Secret{
+		Name:      secret.Name,
+		Namespace: secret.Namespace,
+		Context:   sg.context,
+		Type:      string(secret.Type),
+		Keys:      keys,
+	}
+}
@@ -0,0 +1,47 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSecretGateway_GetYamlMasksValues(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "dev",
+			Annotations: map[string]string{
+				"kubectl.kubernetes.io/last-applied-configuration": `{"stringData":{"password":"hunter2"}}`,
+			},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{"password": []byte("hunter2")},
+	})
+	gateway := k8s.NewSecretGateway(client, "prod")
+
+	out, err := gateway.GetYaml(context.Background(), "dev", "db")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	text := string(out)
+	if strings.Contains(text, "hunter2") || strings.Contains(text, "aHVudGVyMg") {
+		t.Errorf("expected the value masked, got:\n%s", text)
+	}
+	if !strings.Contains(text, "password: '****** (7 bytes)'") {
+		t.Errorf("expected the size of the value, got:\n%s", text)
+	}
+
+	secret, err := gateway.GetByName(context.Background(), "dev", "db")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(secret.Keys) != 1 || secret.Keys[0].Name != "password" || secret.Keys[0].Size != 7 {
+		t.Errorf("unexpected keys %+v", secret.Keys)
+	}
+}
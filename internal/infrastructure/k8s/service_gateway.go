@@ -0,0 +1,161 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type serviceGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewServiceGateway return a serviceGateway struct
+func NewServiceGateway(client kubernetes.Interface, cluster string) port.ServiceResourceGateway {
+	return &serviceGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (sg *serviceGateway) GetAll(ctx context.Context, namespace string) ([]domain.Service, error) {
+	serviceList, err := sg.client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services in namespace %s: %w", namespace, err)
+	}
+	services := []domain.Service{}
+	for _, service := range serviceList.Items {
+		services = append(services, sg.addServiceEntity(service))
+	}
+	return services, nil
+}
+
+func (sg *serviceGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Service, error) {
+	service, err := sg.client.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s in namespace %s: %w", name, namespace, err)
+	}
+	serviceResource := sg.addServiceEntity(*service)
+	return &serviceResource, nil
+}
+
+func (sg *serviceGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.Service, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	serviceList, err := sg.client.CoreV1().Services(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services with labels %v in namespace %s: %w", labelSelector, namespace, err)
+	}
+	services := []domain.Service{}
+	for _, service := range serviceList.Items {
+		services = append(services, sg.addServiceEntity(service))
+	}
+	return services, nil
+}
+
+func (sg *serviceGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	service, err := sg.client.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s in namespace %s: %w", name, namespace, err)
+	}
+	service.ManagedFields = nil
+	return yaml.Marshal(service)
+}
+
+func (sg *serviceGateway) addServiceEntity(service v1.Service) domain.Service {
+	ports := []domain.ServicePort{}
+	for _, servicePort := range service.Spec.Ports {
+		ports = append(ports, domain.ServicePort{
+			Name:       servicePort.Name,
+			Protocol:   string(servicePort.Protocol),
+			Port:       servicePort.Port,
+			TargetPort: servicePort.TargetPort.String(),
+			NodePort:   servicePort.NodePort,
+		})
+	}
+	externalIPs := append([]string{}, service.Spec.ExternalIPs...)
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			externalIPs = append(externalIPs, ingress.IP)
+		} else if ingress.Hostname != "" {
+			externalIPs = append(externalIPs, ingress.Hostname)
+		}
+	}
+	return domain.Service{
+		Name:        service.Name,
+		Namespace:   service.Namespace,
+		Context:     sg.context,
+		Type:        string(service.Spec.Type),
+		ClusterIP:   service.Spec.ClusterIP,
+		ExternalIPs: externalIPs,
+		Selector:    service.Spec.Selector,
+		Ports:       ports,
+	}
+}
+
+func (sg *serviceGateway) GetEndpoints(ctx context.Context, namespace, name string) ([]domain.ServiceEndpoint, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{discoveryv1.LabelServiceName: name}).String(),
+	}
+	sliceList, err := sg.client.DiscoveryV1().EndpointSlices(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpointslices of service %s in namespace %s: %w", name, namespace, err)
+	}
+	endpoints := []domain.ServiceEndpoint{}
+	for _, slice := range sliceList.Items {
+		ports := []string{}
+		for _, slicePort := range slice.Ports {
+			text := ""
+			if slicePort.Port != nil {
+				text = fmt.Sprintf("%d", *slicePort.Port)
+			}
+			if slicePort.Protocol != nil {
+				text += "/" + string(*slicePort.Protocol)
+			}
+			if slicePort.Name != nil && *slicePort.Name != "" {
+				text = *slicePort.Name + ":" + text
+			}
+			ports = append(ports, text)
+		}
+		for _, endpoint := range slice.Endpoints {
+			endpoints = append(endpoints, addEndpointEntity(endpoint, slice.Name, ports))
+		}
+	}
+	return endpoints, nil
+}
+
+// addEndpointEntity converts an endpoint of a slice, the conditions left unset
+// mean ready and serving as the EndpointSlice API specifies
+func addEndpointEntity(endpoint discoveryv1.Endpoint, slice string, ports []string) domain.ServiceEndpoint {
+	serviceEndpoint := domain.ServiceEndpoint{
+		Addresses:   endpoint.Addresses,
+		Ports:       ports,
+		Ready:       endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready,
+		Terminating: endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating,
+		Slice:       slice,
+	}
+	serviceEndpoint.Serving = serviceEndpoint.Ready
+	if endpoint.Conditions.Serving != nil {
+		serviceEndpoint.Serving = *endpoint.Conditions.Serving
+	}
+	if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+		serviceEndpoint.Pod = endpoint.TargetRef.Name
+	}
+	if endpoint.NodeName != nil {
+		serviceEndpoint.Node = *endpoint.NodeName
+	}
+	if endpoint.Zone != nil {
+		serviceEndpoint.Zone = *endpoint.Zone
+	}
+	return serviceEndpoint
+}
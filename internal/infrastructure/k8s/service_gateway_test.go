@@ -0,0 +1,67 @@
+package k8s_test
+
+import (
+	"context"
+	"lazykube/internal/infrastructure/k8s"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func TestServiceGateway_GetEndpoints(t *testing.T) {
+	tcp := v1.ProtocolTCP
+	client := fake.NewSimpleClientset(
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web-abcde",
+				Namespace: "dev",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "web"},
+			},
+			Ports: []discoveryv1.EndpointPort{{Name: ptr.To("http"), Port: ptr.To[int32](8080), Protocol: &tcp}},
+			Endpoints: []discoveryv1.Endpoint{
+				{
+					Addresses: []string{"10.0.0.1"},
+					TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "web-1"},
+					NodeName:  ptr.To("node-a"),
+				},
+				{
+					Addresses:  []string{"10.0.0.2"},
+					Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false), Serving: ptr.To(true), Terminating: ptr.To(true)},
+					TargetRef:  &v1.ObjectReference{Kind: "Pod", Name: "web-2"},
+				},
+			},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "api-fghij",
+				Namespace: "dev",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "api"},
+			},
+			Endpoints: []discoveryv1.Endpoint{{Addresses: []string{"10.0.0.3"}}},
+		},
+	)
+	gateway := k8s.NewServiceGateway(client, "prod")
+
+	endpoints, err := gateway.GetEndpoints(context.Background(), "dev", "web")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(endpoints) != 2 {
+		t.Fatalf("expected the 2 endpoints of the service, got %+v", endpoints)
+	}
+	first := endpoints[0]
+	if !first.Ready || !first.Serving || first.Pod != "web-1" || first.Node != "node-a" || first.Slice != "web-abcde" {
+		t.Errorf("expected unset conditions to mean ready, got %+v", first)
+	}
+	if len(first.Ports) != 1 || first.Ports[0] != "http:8080/TCP" {
+		t.Errorf("unexpected ports %v", first.Ports)
+	}
+	second := endpoints[1]
+	if second.Ready || !second.Serving || !second.Terminating {
+		t.Errorf("expected a terminating endpoint still serving, got %+v", second)
+	}
+}
@@ -0,0 +1,120 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type statefulSetGateway struct {
+	client  kubernetes.Interface
+	context string
+}
+
+// NewStatefulSetGateway return a statefulSetGateway struct
+func NewStatefulSetGateway(client kubernetes.Interface, cluster string) port.StatefulSetResourceGateway {
+	return &statefulSetGateway{
+		client:  client,
+		context: cluster,
+	}
+}
+
+func (sg *statefulSetGateway) GetAll(ctx context.Context, namespace string) ([]domain.StatefulSet, error) {
+	statefulSetList, err := sg.client.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets in namespace %s: %w", namespace, err)
+	}
+	statefulSets := []domain.StatefulSet{}
+	for _, statefulSet := range statefulSetList.Items {
+		statefulSets = append(statefulSets, sg.addStatefulSetEntity(statefulSet))
+	}
+	return statefulSets, nil
+}
+
+func (sg *statefulSetGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.StatefulSet, error) {
+	statefulSet, err := sg.client.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statefulset %s in namespace %s: %w", name, namespace, err)
+	}
+	statefulSetResource := sg.addStatefulSetEntity(*statefulSet)
+	return &statefulSetResource, nil
+}
+
+func (sg *statefulSetGateway) GetByLabels(ctx context.Context, namespace string, labelSelector map[string]string) ([]domain.StatefulSet, error) {
+	options := metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labelSelector).String(),
+	}
+	statefulSetList, err := sg.client.AppsV1().StatefulSets(namespace).List(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets with labels %v in namespace %s: %w", labelSelector, namespace, err)
+	}
+	statefulSets := []domain.StatefulSet{}
+	for _, statefulSet := range statefulSetList.Items {
+		statefulSets = append(statefulSets, sg.addStatefulSetEntity(statefulSet))
+	}
+	return statefulSets, nil
+}
+
+func (sg *statefulSetGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	statefulSet, err := sg.client.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statefulset %s in namespace %s: %w", name, namespace, err)
+	}
+	statefulSet.ManagedFields = nil
+	return yaml.Marshal(statefulSet)
+}
+
+// GetPods returns the pods controlled by the statefulset
+func (sg *statefulSetGateway) GetPods(ctx context.Context, statefulSetName, namespace string) ([]domain.Pod, error) {
+	statefulSet, err := sg.client.AppsV1().StatefulSets(namespace).Get(ctx, statefulSetName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statefulset %s in namespace %s: %w", statefulSetName, namespace, err)
+	}
+	pods, err := ownedPods(ctx, sg.client, sg.context, namespace, statefulSet.Spec.Selector, map[types.UID]bool{statefulSet.UID: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for statefulset %s: %w", statefulSetName, err)
+	}
+	return pods, nil
+}
+
+func (sg *statefulSetGateway) Restart(ctx context.Context, namespace, name string) error {
+	_, err := sg.client.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, restartPatch(metav1.Now()), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to restart statefulset %s in namespace %s: %w", name, namespace, err)
+	}
+	return nil
+}
+
+func (sg *statefulSetGateway) Scale(ctx context.Context, namespace, name string, replicas int32) error {
+	patch := fmt.Appendf(nil, `{"spec":{"replicas":%d}}`, replicas)
+	_, err := sg.client.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to scale statefulset %s in namespace %s: %w", name, namespace, err)
+	}
+	return nil
+}
+
+func (sg *statefulSetGateway) addStatefulSetEntity(statefulSet appsv1.StatefulSet) domain.StatefulSet {
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	return domain.StatefulSet{
+		Name:            statefulSet.Name,
+		Namespace:       statefulSet.Namespace,
+		Context:         sg.context,
+		Replicas:        replicas,
+		ReadyReplicas:   statefulSet.Status.ReadyReplicas,
+		CurrentReplicas: statefulSet.Status.CurrentReplicas,
+		UpdatedReplicas: statefulSet.Status.UpdatedReplicas,
+		ServiceName:     statefulSet.Spec.ServiceName,
+	}
+}
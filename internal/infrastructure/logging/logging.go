@@ -0,0 +1,43 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"k8s.io/klog/v2"
+)
+
+// DefaultLogFile is used by the debug mode when no log file is given
+func DefaultLogFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "lazykube.log"
+	}
+	return filepath.Join(home, ".config", "lazykube", "lazykube.log")
+}
+
+// Setup sends the structured logs, and the ones of client-go, to the file
+// with the level. Without a file the logs are dropped, nothing may be written
+// to the terminal of the tui. The returned function closes the file.
+func Setup(path string, level slog.Level) (func() error, error) {
+	if path == "" {
+		logger := slog.New(slog.DiscardHandler)
+		slog.SetDefault(logger)
+		klog.SetSlogLogger(logger)
+		return func() error { return nil }, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("creating the log directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("opening the log file: %w", err)
+	}
+	logger := slog.New(slog.NewJSONHandler(file, &slog.HandlerOptions{Level: level}))
+	slog.SetDefault(logger)
+	klog.SetSlogLogger(logger.With("source", "client-go"))
+	return file.Close, nil
+}
@@ -0,0 +1,184 @@
+package tui
+
+import (
+	"fmt"
+	"lazykube/internal/domain"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// resourceKey is a key of the resources table with the permission it needs
+// in the cluster and the action it runs, both empty when not needed
+type resourceKey struct {
+	Key        string
+	Label      string
+	Permission domain.Permission
+	Action     domain.Action
+	// Types are the only types with the key, every type when empty
+	Types []string
+}
+
+var workloadTypes = []string{"Pods", "Deployments", "StatefulSets", "DaemonSets"}
+
+var resourceKeys = []resourceKey{
+	{Key: "l", Label: "Logs", Permission: domain.PermissionLogs, Types: []string{"Pods", "Deployments", "StatefulSets", "DaemonSets", "Jobs"}},
+	{Key: "y", Label: "YAML", Permission: domain.PermissionGet},
+	{Key: "x", Label: "Decode Secret", Permission: domain.PermissionGet, Types: []string{"Secrets"}},
+	{Key: "Y", Label: "Copy Secret Value", Permission: domain.PermissionGet, Types: []string{"Secrets"}},
+	{Key: "v", Label: "Preview Key", Permission: domain.PermissionGet, Types: []string{"ConfigMaps"}},
+	{Key: "V", Label: "Edit Key", Permission: domain.PermissionPatch, Action: domain.ActionEdit, Types: []string{"ConfigMaps"}},
+	{Key: "u", Label: "Used By", Permission: domain.PermissionGet, Types: []string{"ConfigMaps"}},
+	{Key: "s", Label: "Suspend/Resume", Permission: domain.PermissionPatch, Action: domain.ActionSuspend, Types: []string{"CronJobs"}},
+	{Key: "t", Label: "Trigger Now", Permission: domain.PermissionTrigger, Action: domain.ActionTrigger, Types: []string{"CronJobs"}},
+	{Key: "b", Label: "Endpoints", Permission: domain.PermissionGet, Types: []string{"Services"}},
+	{Key: "b", Label: "Routes", Permission: domain.PermissionGet, Types: []string{"Ingresses", "HTTPRoutes"}},
+	{Key: "R", Label: "Restart", Permission: domain.PermissionPatch, Action: domain.ActionRestart, Types: []string{"StatefulSets", "DaemonSets"}},
+	{Key: "S", Label: "Scale", Permission: domain.PermissionPatch, Action: domain.ActionScale, Types: []string{"StatefulSets"}},
+	{Key: "S", Label: "Min/Max Replicas", Permission: domain.PermissionPatch, Action: domain.ActionEdit, Types: []string{"HorizontalPodAutoscalers"}},
+	{Key: "h", Label: "Autoscaler", Permission: domain.PermissionGet, Types: []string{"Deployments", "StatefulSets", "HorizontalPodAutoscalers"}},
+	{Key: "e", Label: "Exec", Permission: domain.PermissionExec, Action: domain.ActionExec, Types: workloadTypes},
+	{Key: "E", Label: "Exec Cmd", Permission: domain.PermissionExec, Action: domain.ActionExec, Types: workloadTypes},
+	{Key: "D", Label: "Debug", Permission: domain.PermissionDebug, Action: domain.ActionDebug, Types: workloadTypes},
+	{Key: "c", Label: "Copy", Permission: domain.PermissionExec, Action: domain.ActionCopy, Types: workloadTypes},
+	{Key: "p", Label: "Port Forward", Permission: domain.PermissionPortForward, Action: domain.ActionPortForward, Types: []string{"Pods", "Deployments", "StatefulSets", "DaemonSets", "Services"}},
+	{Key: "a", Label: "Pause Refresh"},
+	{Key: "Enter", Label: "View YAML", Permission: domain.PermissionGet},
+}
+
+// accessResources are the api group and resource of every type, for the access reviews
+var accessResources = map[string][2]string{
+	"Pods":                     {"", "pods"},
+	"Deployments":              {"apps", "deployments"},
+	"Services":                 {"", "services"},
+	"Secrets":                  {"", "secrets"},
+	"ConfigMaps":               {"", "configmaps"},
+	"Jobs":                     {"batch", "jobs"},
+	"CronJobs":                 {"batch", "cronjobs"},
+	"StatefulSets":             {"apps", "statefulsets"},
+	"DaemonSets":               {"apps", "daemonsets"},
+	"Ingresses":                {"networking.k8s.io", "ingresses"},
+	"HTTPRoutes":               {"gateway.networking.k8s.io", "httproutes"},
+	"PersistentVolumeClaims":   {"", "persistentvolumeclaims"},
+	"PersistentVolumes":        {"", "persistentvolumes"},
+	"HorizontalPodAutoscalers": {"autoscaling", "horizontalpodautoscalers"},
+}
+
+// formatResourceKeys returns the keybindings of the table for the type. The read-only
+// mode hides the actions and the permissions denied are greyed out with the verb.
+func formatResourceKeys(typeR string, readOnly bool, access *domain.Access) string {
+	keys := []string{}
+	if readOnly {
+		keys = append(keys, "[red]READ-ONLY[white]")
+	}
+	for _, key := range resourceKeys {
+		if readOnly && key.Action != "" {
+			continue
+		}
+		if len(key.Types) > 0 && !slices.Contains(key.Types, typeR) {
+			continue
+		}
+		if access != nil && key.Permission != "" && !access.Can(key.Permission) {
+			keys = append(keys, fmt.Sprintf("[gray]%s: %s (no %s)[white]", key.Key, key.Label, access.Denied[key.Permission]))
+			continue
+		}
+		keys = append(keys, fmt.Sprintf("[red]%s[white]: %s", key.Key, key.Label))
+	}
+	return strings.Join(keys, " | ")
+}
+
+// accessFor returns the access of the user to the type in the namespace, nil
+// while it is unknown. The first call reviews it in background and updates the
+// keybindings when it ends, the next calls review again the permissions whose
+// review failed. It runs in the ui goroutine.
+func (rD *resourceDict) accessFor(typeR, kubeContext, namespace string) *domain.Access {
+	resource, ok := accessResources[typeR]
+	if !ok {
+		return nil
+	}
+	key := strings.Join([]string{typeR, kubeContext, namespace}, "/")
+	access := rD.access[key]
+	if rD.reviewing[key] || (access != nil && len(access.Failed) == 0) {
+		return access
+	}
+
+	// Only the permissions that failed are reviewed again, the others stay cached
+	permissions := []domain.Permission{}
+	if access != nil {
+		for permission := range access.Failed {
+			permissions = append(permissions, permission)
+		}
+	}
+	rD.reviewing[key] = true
+	go func() {
+		ctx, cancel := rD.requestContext()
+		defer cancel()
+		reviewed, err := rD.Controller.Access.Review(ctx, kubeContext, namespace, resource[0], resource[1], permissions...)
+		rD.App.QueueUpdateDraw(func() {
+			delete(rD.reviewing, key)
+			// Nothing was reviewed, like without a gateway for the context
+			if err != nil && reviewed.Denied == nil {
+				return
+			}
+			rD.access[key] = mergeAccess(rD.access[key], reviewed)
+			rD.updateResourceKeys()
+		})
+	}()
+	return access
+}
+
+// mergeAccess adds the permissions reviewed again to the cached access
+func mergeAccess(cached *domain.Access, reviewed domain.Access) *domain.Access {
+	if cached == nil {
+		return &reviewed
+	}
+	merged := domain.Access{
+		Context:   cached.Context,
+		Namespace: cached.Namespace,
+		Denied:    maps.Clone(cached.Denied),
+		Failed:    reviewed.Failed,
+	}
+	maps.Copy(merged.Denied, reviewed.Denied)
+	return &merged
+}
+
+// selectedAccess returns the access to the resource of the selected row
+func (rD *resourceDict) selectedAccess() (*domain.Access, string) {
+	row, _ := rD.Table.GetSelection()
+	if _, isError := rD.Table.Errors[row]; row < 1 || isError || row >= rD.Table.GetRowCount() {
+		return nil, ""
+	}
+	namespace := rD.Table.GetCell(row, 1).Text
+	kubeContext := rD.Table.GetCell(row, 2).Text
+	return rD.accessFor(rD.Table.ResourceType, kubeContext, namespace), namespace
+}
+
+// updateResourceKeys shows the keybindings of the table for the selected row
+func (rD *resourceDict) updateResourceKeys() {
+	if rD.App.GetFocus() != rD.Table {
+		return
+	}
+	access, _ := rD.selectedAccess()
+	rD.Keybinding.SetKeybindings(formatResourceKeys(rD.Table.ResourceType, rD.Config.ReadOnly, access))
+}
+
+// deniedKey reports if the user can't run the key on the selected row, and
+// shows the verb denied
+func (rD *resourceDict) deniedKey(key string) bool {
+	access, namespace := rD.selectedAccess()
+	if access == nil {
+		return false
+	}
+	for _, resourceKey := range resourceKeys {
+		if len(resourceKey.Types) > 0 && !slices.Contains(resourceKey.Types, rD.Table.ResourceType) {
+			continue
+		}
+		if resourceKey.Key == key && resourceKey.Permission != "" && !access.Can(resourceKey.Permission) {
+			rD.ErrorModal.SetText(fmt.Sprintf("Forbidden in %s, namespace %s: %s",
+				access.Context, namespace, access.Denied[resourceKey.Permission]))
+			rD.Pages.ShowPage("errorModal")
+			return true
+		}
+	}
+	return false
+}
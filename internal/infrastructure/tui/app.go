@@ -3,6 +3,7 @@ package tui
 import (
 	"iter"
 	"lazykube/internal/adapter/controller"
+	"lazykube/internal/domain"
 	"lazykube/internal/infrastructure/config"
 
 	"github.com/gdamore/tcell/v2"
@@ -20,6 +21,11 @@ func NewApp(clusters iter.Seq[string],
 
 	// Create every item
 	mainApp := tview.NewApplication()
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		panic(err)
+	}
+	mainApp.SetScreen(screen)
 
 	// Set global tview styles for a dark theme
 	tview.Styles.PrimitiveBackgroundColor = tcell.ColorDefault
@@ -45,6 +51,7 @@ func NewApp(clusters iter.Seq[string],
 	resourceDict.Menu = menu
 	resourceDict.Namespace = namespaceList
 	resourceDict.App = mainApp
+	resourceDict.Screen = screen
 	resourceDict.Type = typeList
 	resourceDict.Pages = pages
 	resourceDict.Filter = filterInput
@@ -52,25 +59,41 @@ func NewApp(clusters iter.Seq[string],
 	resourceDict.Keybinding = keybindingView
 	resourceDict.LogView = logView
 	resourceDict.Controller = &controller
+	resourceDict.access = map[string]*domain.Access{}
+	resourceDict.reviewing = map[string]bool{}
 
 	layout := NewLayout(resourceDict)
 
 	pages.AddPage("main", layout, true, true)
+	portForwards := NewPortForwardsView(resourceDict)
+	resourceDict.PortForwards = portForwards
+	pages.AddPage("portForwards", portForwards, true, false)
+	audit := NewAuditView(resourceDict)
+	resourceDict.Audit = audit
+	pages.AddPage("audit", audit, true, false)
 	errorModal := NewErrorModal(resourceDict)
 	resourceDict.ErrorModal = errorModal
 
 	keybindingsMap := map[string]string{
-		"Clusters":   "[red]space[white]: Select | [red]c[white]: Clear | [red]f[white]: Select All | [red]Enter[white]: Apply",
-		"Namespaces": "[red]space[white]: Select | [red]c[white]: Clear | [red]f[white]: Select All | [red]Enter[white]: Apply",
-		"Types":      "[red]Enter[white]: Apply",
-		"Filter":     "[red]Enter[white]: Apply Filter",
-		"Resources":  "[red]l[white]: Logs | [red]y[white]: YAML | [red]e[white]: Exec | [red]p[white]: Port Forward | [red]d[white]: Describe | [red]Del[white]: Delete | [red]Enter[white]: View YAML",
-		"YAML View":  "[red]q[white]: Close",
-		"Logs":       "[red]Esc[white]: Close",
-		"Default":    "[red]1[white]: Clusters | [red]2[white]: Namespaces | [red]3[white]: Types | [red]4[white]: Filter | [red]5[white]: Table | [red]6[white]: YAML | [red]q[white]: Quit",
+		"Clusters":      "[red]space[white]: Select | [red]c[white]: Clear | [red]f[white]: Select All | [red]h[white]: Health | [red]Enter[white]: Apply",
+		"Namespaces":    "[red]space[white]: Select | [red]c[white]: Clear | [red]f[white]: Select All | [red]Enter[white]: Apply",
+		"Types":         "[red]Enter[white]: Apply",
+		"Filter":        "[red]Enter[white]: Apply Filter",
+		"Resources":     formatResourceKeys("", conf.ReadOnly, nil),
+		"YAML View":     "[red]q[white]: Close",
+		"Logs":          "[red]Esc[white]: Close",
+		"Port Forwards": "[red]s/Del[white]: Stop | [red]x[white]: Remove | [red]X[white]: Remove Ended | [red]P[white]: Start Profile | [red]Esc[white]: Close",
+		"Audit":         "[red]/[white]: Filter | [red]r[white]: Reload | [red]Esc[white]: Close",
+		"Default":       "[red]1[white]: Clusters | [red]2[white]: Namespaces | [red]3[white]: Types | [red]4[white]: Filter | [red]5[white]: Table | [red]6[white]: YAML | [red]7[white]: Port Forwards | [red]8[white]: Audit | [red]q[white]: Quit",
 	}
 
 	setFocus := func(p tview.Primitive) {
+		if p != resourceDict.PortForwards {
+			pages.HidePage("portForwards")
+		}
+		if p != resourceDict.Audit {
+			pages.HidePage("audit")
+		}
 		mainApp.SetFocus(p)
 		var contextTitle string
 		switch p {
@@ -88,6 +111,10 @@ func NewApp(clusters iter.Seq[string],
 			contextTitle = "YAML View"
 		case resourceDict.LogView:
 			contextTitle = "Logs"
+		case resourceDict.PortForwards:
+			contextTitle = "Port Forwards"
+		case resourceDict.Audit:
+			contextTitle = "Audit"
 		default:
 			contextTitle = "Default"
 		}
@@ -97,10 +124,14 @@ func NewApp(clusters iter.Seq[string],
 		} else {
 			resourceDict.Keybinding.SetKeybindings(keybindingsMap["Default"])
 		}
+		resourceDict.updateResourceKeys()
 	}
 
 	resourceDict.SetFocus = setFocus
 
+	menu.StartHealthChecks()
+	tableResource.StartAutoRefresh(resourceDict)
+
 	// Set initial focus to the menu
 	mainApp.SetFocus(resourceDict.Menu)
 	resourceDict.Keybinding.SetKeybindings(keybindingsMap["Clusters"])
@@ -111,6 +142,10 @@ func NewApp(clusters iter.Seq[string],
 			// Consume Ctrl-C globally to prevent application exit
 			return nil
 		}
+		if isTextInput(mainApp.GetFocus()) {
+			// The shortcuts must not steal the keys typed in a field
+			return event
+		}
 		if event.Rune() == '1' {
 			setFocus(resourceDict.Menu)
 		}
@@ -129,6 +164,12 @@ func NewApp(clusters iter.Seq[string],
 		if event.Rune() == '6' {
 			setFocus(resourceDict.View)
 		}
+		if event.Rune() == '7' {
+			resourceDict.showPortForwards()
+		}
+		if event.Rune() == '8' {
+			resourceDict.showAudit()
+		}
 		if event.Rune() == 'q' {
 			mainApp.Stop()
 		}
@@ -138,4 +179,13 @@ func NewApp(clusters iter.Seq[string],
 	if err := mainApp.SetRoot(pages, true).EnableMouse(true).Run(); err != nil {
 		panic(err)
 	}
-}
\ No newline at end of file
+}
+
+// isTextInput reports if the primitive receives free text from the user
+func isTextInput(p tview.Primitive) bool {
+	switch p.(type) {
+	case *tview.InputField, *tview.TextArea, *filterInputField, *Input:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package tui
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/domain"
+	"strings"
+)
+
+// record appends the action to the audit journal with the kubectl command equivalent to it
+func (rD *resourceDict) record(action domain.Action, kubeContext, namespace, object string, args []string, err error) {
+	if rD.Controller == nil {
+		return
+	}
+	recordAction(rD.Controller, auditEntry(action, kubeContext, namespace, object, args), err)
+}
+
+// recordStarted appends a long action when it starts, its result is recorded
+// with record when it ends
+func (rD *resourceDict) recordStarted(action domain.Action, kubeContext, namespace, object string, args []string) {
+	if rD.Controller == nil {
+		return
+	}
+	entry := auditEntry(action, kubeContext, namespace, object, args)
+	entry.Outcome = domain.AuditStarted
+	recordAction(rD.Controller, entry, nil)
+}
+
+func recordAction(controllers *controller.AppController, entry domain.AuditEntry, err error) {
+	if controllers.Audit == nil {
+		return
+	}
+	controllers.Audit.Record(entry, err)
+}
+
+func auditEntry(action domain.Action, kubeContext, namespace, object string, args []string) domain.AuditEntry {
+	return domain.AuditEntry{
+		Context:   kubeContext,
+		Namespace: namespace,
+		Object:    object,
+		Action:    action,
+		Command:   kubectlCommand(kubeContext, namespace, args...),
+	}
+}
+
+// kubectlCommand builds the kubectl command line for the context and namespace
+func kubectlCommand(kubeContext, namespace string, args ...string) string {
+	parts := []string{"kubectl"}
+	if kubeContext != "" {
+		parts = append(parts, "--context", kubeContext)
+	}
+	if namespace != "" {
+		parts = append(parts, "-n", namespace)
+	}
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t'\"$") {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, " ")
+}
+
+// portForwardObject is the object of kubectl port-forward for the target
+func portForwardObject(target domain.PortForwardTarget) string {
+	switch {
+	case target.Service != "":
+		return "service/" + target.Service
+	case target.Pod != "":
+		return "pod/" + target.Pod
+	default:
+		return "deployment/" + target.Deployment
+	}
+}
+
+// startPortForward starts the session and records it in the audit journal
+func (rD *resourceDict) startPortForward(target domain.PortForwardTarget, ports []string, reconnect bool) error {
+	return StartPortForward(rD.Controller, target, ports, reconnect)
+}
+
+// StartPortForward starts the session and records it in the audit journal as
+// started, a session that fails later is recorded again with its error
+func StartPortForward(controllers *controller.AppController, target domain.PortForwardTarget, ports []string, reconnect bool) error {
+	object := portForwardObject(target)
+	entry := auditEntry(domain.ActionPortForward, target.Context, target.Namespace, object, append([]string{"port-forward", object}, ports...))
+	// The failure is recorded after the start, even when the session fails at once
+	started := make(chan struct{})
+	_, err := controllers.PortForward.Start(target, ports, reconnect, func(err error) {
+		<-started
+		recordAction(controllers, entry, err)
+	})
+	if err != nil {
+		recordAction(controllers, entry, err)
+	} else {
+		startedEntry := entry
+		startedEntry.Outcome = domain.AuditStarted
+		recordAction(controllers, startedEntry, nil)
+	}
+	close(started)
+	return err
+}
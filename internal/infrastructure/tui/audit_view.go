@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"lazykube/internal/domain"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// auditView is the page that browses the audit journal
+type auditView struct {
+	*tview.Flex
+	table  *tview.Table
+	filter *tview.InputField
+	dict   *resourceDict
+}
+
+var auditHeaders = []string{"TIME", "CONTEXT", "NAMESPACE", "OBJECT", "ACTION", "OUTCOME", "COMMAND"}
+
+func NewAuditView(dict *resourceDict) *auditView {
+	table := tview.NewTable()
+	table.SetSelectable(true, false)
+	table.SetFixed(1, 0)
+	filter := tview.NewInputField().SetLabel("Filter: ")
+
+	flex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(filter, 1, 0, false).
+		AddItem(table, 0, 1, true)
+	flex.SetBorder(true).SetTitle("Audit [8]")
+
+	view := &auditView{
+		Flex:   flex,
+		table:  table,
+		filter: filter,
+		dict:   dict,
+	}
+
+	filter.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			view.Refresh()
+		}
+		dict.App.SetFocus(table)
+	})
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEsc:
+			dict.Pages.HidePage("audit")
+			dict.SetFocus(dict.Table)
+			return nil
+		case event.Rune() == '/':
+			dict.App.SetFocus(filter)
+			return nil
+		case event.Rune() == 'r':
+			view.Refresh()
+			return nil
+		}
+		return event
+	})
+
+	return view
+}
+
+// Refresh fills the table with the entries that match the filter, newest first
+func (av *auditView) Refresh() {
+	av.table.Clear()
+	for col, header := range auditHeaders {
+		av.table.SetCell(0, col, tview.NewTableCell(header).SetSelectable(false))
+	}
+	entries, err := av.dict.Controller.Audit.List(av.filter.GetText())
+	if err != nil {
+		av.table.SetCell(1, 0, tview.NewTableCell(err.Error()).SetTextColor(tcell.ColorRed).SetSelectable(false))
+		return
+	}
+	for i, entry := range entries {
+		r := i + 1
+		av.table.SetCell(r, 0, tview.NewTableCell(entry.Time.Local().Format("2006-01-02 15:04:05")))
+		av.table.SetCell(r, 1, tview.NewTableCell(entry.Context))
+		av.table.SetCell(r, 2, tview.NewTableCell(entry.Namespace))
+		av.table.SetCell(r, 3, tview.NewTableCell(entry.Object))
+		av.table.SetCell(r, 4, tview.NewTableCell(string(entry.Action)).SetTextColor(auditActionColor(entry.Action)))
+		av.table.SetCell(r, 5, tview.NewTableCell(entry.Outcome).SetTextColor(auditOutcomeColor(entry.Outcome)))
+		av.table.SetCell(r, 6, tview.NewTableCell(entry.Command))
+	}
+	av.table.Select(1, 0).ScrollToBeginning()
+}
+
+func auditActionColor(action domain.Action) tcell.Color {
+	if action.Destructive() {
+		return tcell.ColorRed
+	}
+	return tcell.ColorWhite
+}
+
+func auditOutcomeColor(outcome string) tcell.Color {
+	switch {
+	case outcome == "ok":
+		return tcell.ColorGreen
+	case strings.HasPrefix(outcome, "error"):
+		return tcell.ColorRed
+	}
+	return tcell.ColorYellow
+}
@@ -0,0 +1,36 @@
+package tui
+
+import (
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// NewAutoscalerModal creates a modal window for editing the min and max replicas of an autoscaler.
+// 'onDone' is called with the replicas written by the user, or with ok false on cancellation.
+func NewAutoscalerModal(minReplicas, maxReplicas int, onDone func(minReplicas, maxReplicas string, ok bool)) *tview.Grid {
+	form := tview.NewForm()
+	form.SetBackgroundColor(tcell.ColorGray)
+	form.SetBorder(true)
+	form.SetTitle("Autoscaler Replicas")
+
+	form.AddInputField("Min replicas", strconv.Itoa(minReplicas), 10, tview.InputFieldInteger, nil)
+	form.AddInputField("Max replicas", strconv.Itoa(maxReplicas), 10, tview.InputFieldInteger, nil)
+
+	form.AddButton("OK", func() {
+		minField := form.GetFormItem(0).(*tview.InputField)
+		maxField := form.GetFormItem(1).(*tview.InputField)
+		onDone(minField.GetText(), maxField.GetText(), true)
+	})
+	form.AddButton("Cancel", func() {
+		onDone("", "", false)
+	})
+
+	grid := tview.NewGrid().
+		SetRows(0, 9, 0).
+		SetColumns(0, 40, 0).
+		AddItem(form, 1, 1, 1, 1, 0, 0, true)
+
+	return grid
+}
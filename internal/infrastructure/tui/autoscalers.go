@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"strconv"
+	"strings"
+)
+
+// autoscalerKinds are the kinds of the scale targets of the workload types
+var autoscalerKinds = map[string]string{
+	"Deployments":  "Deployment",
+	"StatefulSets": "StatefulSet",
+}
+
+// showAutoscaler describes in the yaml view the autoscaler of the row, or the one
+// that scales the workload of the row
+func (rD *resourceDict) showAutoscaler(typeR, namespace, name, kubeContext string) {
+	target := yamlTarget{typeR: typeR, namespace: namespace, name: name, kubeContext: kubeContext, mode: yamlModeAutoscaler}
+	rD.yaml = &target
+	rD.loadYaml(target, true)
+}
+
+func (rD *resourceDict) autoscalerContent(ctx context.Context, target yamlTarget) ([]byte, error) {
+	if target.typeR == "HorizontalPodAutoscalers" {
+		autoscaler, err := rD.Controller.HPA.GetAutoscaler(ctx, target.name, target.namespace, target.kubeContext)
+		if err != nil {
+			return nil, err
+		}
+		return describeAutoscaler(*autoscaler), nil
+	}
+	kind := autoscalerKinds[target.typeR]
+	autoscaler, err := rD.Controller.HPA.GetForTarget(ctx, kind, target.name, target.namespace, target.kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	if autoscaler == nil {
+		return fmt.Appendf(nil, "# no horizontalpodautoscaler scales %s %s/%s\n", kind, target.namespace, target.name), nil
+	}
+	return describeAutoscaler(*autoscaler), nil
+}
+
+func describeAutoscaler(autoscaler domain.HorizontalPodAutoscaler) []byte {
+	lines := []string{
+		fmt.Sprintf("# horizontalpodautoscaler %s/%s scales %s %s", autoscaler.Namespace, autoscaler.Name, autoscaler.TargetKind, autoscaler.TargetName),
+		fmt.Sprintf("replicas: current %d, desired %d, min %d, max %d",
+			autoscaler.CurrentReplicas, autoscaler.DesiredReplicas, autoscaler.MinReplicas, autoscaler.MaxReplicas),
+		"metrics:",
+	}
+	for _, metric := range autoscaler.Metrics {
+		lines = append(lines, fmt.Sprintf("  %s: %s / target %s", metric.Name, metric.Current, metric.Target))
+	}
+	lines = append(lines, "conditions:")
+	for _, condition := range autoscaler.Conditions {
+		lines = append(lines, fmt.Sprintf("  %s=%s (%s): %s", condition.Type, condition.Status, condition.Reason, condition.Message))
+	}
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+// showEditAutoscaler asks for the min and max replicas of the autoscaler and patches it
+func (rD *resourceDict) showEditAutoscaler(namespace, name, kubeContext string, minReplicas, maxReplicas int) {
+	modal := NewAutoscalerModal(minReplicas, maxReplicas, func(minText, maxText string, ok bool) {
+		rD.Pages.RemovePage("autoscaler")
+		rD.SetFocus(rD.Table)
+		if !ok {
+			return
+		}
+		minValue, minErr := strconv.ParseInt(minText, 10, 32)
+		maxValue, maxErr := strconv.ParseInt(maxText, 10, 32)
+		if minErr != nil || maxErr != nil {
+			rD.ErrorModal.SetText(fmt.Sprintf("invalid replicas: min %q, max %q", minText, maxText))
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		go rD.updateAutoscaler(namespace, name, kubeContext, int32(minValue), int32(maxValue))
+	})
+	rD.Pages.AddPage("autoscaler", modal, true, true)
+	rD.SetFocus(modal)
+}
+
+// updateAutoscaler sets the min and max replicas of the autoscaler and refreshes the table
+func (rD *resourceDict) updateAutoscaler(namespace, name, kubeContext string, minReplicas, maxReplicas int32) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	err := rD.Controller.HPA.UpdateReplicas(ctx, name, namespace, kubeContext, minReplicas, maxReplicas)
+	patch := fmt.Sprintf(`{"spec":{"minReplicas":%d,"maxReplicas":%d}}`, minReplicas, maxReplicas)
+	rD.record(domain.ActionEdit, kubeContext, namespace, "hpa/"+name, []string{"patch", "hpa", name, "-p", patch}, err)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		if rD.Table.query != nil {
+			rD.refresh(*rD.Table.query)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// NewCommandModal creates a modal window for entering the command to execute in a container.
+// 'onOk' is called with the command line written by the user, or with an empty string on cancellation.
+func NewCommandModal(onOk func(command string)) *tview.Grid {
+	form := tview.NewForm()
+	form.SetBackgroundColor(tcell.ColorGray)
+	form.SetBorder(true)
+	form.SetTitle("Exec Command")
+
+	form.AddInputField("Command", "", 60, nil, nil)
+
+	form.AddButton("OK", func() {
+		inputField := form.GetFormItem(0).(*tview.InputField)
+		onOk(inputField.GetText())
+	})
+	form.AddButton("Cancel", func() {
+		onOk("")
+	})
+
+	grid := tview.NewGrid().
+		SetRows(0, 7, 0).
+		SetColumns(0, 80, 0).
+		AddItem(form, 1, 1, 1, 1, 0, 0, true)
+
+	return grid
+}
+
+// splitCommandLine splits a command line in arguments like a posix shell does,
+// honoring single quotes, double quotes and backslash escapes.
+func splitCommandLine(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errors.New("unterminated quote or escape in command")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
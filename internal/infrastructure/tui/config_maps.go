@@ -0,0 +1,159 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"lazykube/internal/domain"
+	"os"
+	"strings"
+)
+
+// formatExtensions are the extensions of the files edited for every format
+var formatExtensions = map[string]string{
+	domain.FormatJSON:       ".json",
+	domain.FormatYAML:       ".yaml",
+	domain.FormatProperties: ".properties",
+	domain.FormatText:       ".txt",
+}
+
+// selectConfigMapKey asks for a key of the configmap, onKey is called in the ui goroutine
+func (rD *resourceDict) selectConfigMapKey(namespace, name, kubeContext, title string, onKey func(key domain.ConfigMapKey)) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	keys, err := rD.Controller.ConfigMap.GetKeys(ctx, name, namespace, kubeContext)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		if len(keys) == 0 {
+			rD.ErrorModal.SetText(fmt.Sprintf("ConfigMap %s has no text values.", name))
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		labels := make([]string, len(keys))
+		byLabel := map[string]domain.ConfigMapKey{}
+		for i, key := range keys {
+			labels[i] = fmt.Sprintf("%s (%s, %s)", key.Name, key.Format, formatBytes(int64(key.Size)))
+			byLabel[labels[i]] = key
+		}
+		modal, list := NewContainerSelectionModal(labels, func(label string) {
+			rD.Pages.RemovePage("configMapKeySelection")
+			rD.SetFocus(rD.Table)
+			if label != "" {
+				onKey(byLabel[label])
+			}
+		})
+		list.SetBorder(true).SetTitle(title)
+		rD.Pages.AddPage("configMapKeySelection", modal, true, true)
+		rD.SetFocus(list)
+	})
+}
+
+// showConfigMapKey previews the value of the key in the yaml view
+func (rD *resourceDict) showConfigMapKey(namespace, name, kubeContext, key string) {
+	target := yamlTarget{typeR: "ConfigMaps", namespace: namespace, name: name, kubeContext: kubeContext, mode: yamlModeKey, key: key}
+	rD.yaml = &target
+	rD.loadYaml(target, true)
+}
+
+// showConfigMapReferences lists the pods that use the configmap in the yaml view
+func (rD *resourceDict) showConfigMapReferences(namespace, name, kubeContext string) {
+	target := yamlTarget{typeR: "ConfigMaps", namespace: namespace, name: name, kubeContext: kubeContext, mode: yamlModeReferences}
+	rD.yaml = &target
+	rD.loadYaml(target, true)
+}
+
+// editConfigMapKey opens the value of the key in the editor of the user and
+// patches the configmap when it changed. A refused edit stays in its file.
+func (rD *resourceDict) editConfigMapKey(namespace, name, kubeContext string, key domain.ConfigMapKey) {
+	ctx, cancel := rD.requestContext()
+	value, err := rD.Controller.ConfigMap.GetValue(ctx, name, namespace, kubeContext, key.Name)
+	cancel()
+	if err == nil {
+		var (
+			edited []byte
+			path   string
+		)
+		edited, path, err = rD.editInEditor([]byte(value), formatExtensions[key.Format])
+		if err == nil && string(edited) == value {
+			os.Remove(path)
+			rD.App.QueueUpdateDraw(func() {
+				rD.View.SetText(fmt.Sprintf("No changes in %s of configmap %s/%s.", key.Name, namespace, name))
+			})
+			return
+		}
+		if err == nil {
+			ctx, cancel := rD.requestContext()
+			err = rD.Controller.ConfigMap.UpdateValue(ctx, name, namespace, kubeContext, key.Name, value, string(edited))
+			cancel()
+			rD.record(domain.ActionEdit, kubeContext, namespace, "configmap/"+name, []string{"edit", "configmap/" + name}, err)
+			if err == nil {
+				os.Remove(path)
+			} else {
+				err = fmt.Errorf("%w\nThe edited value is kept in %s", err, path)
+			}
+		}
+	}
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		rD.showConfigMapKey(namespace, name, kubeContext, key.Name)
+	})
+}
+
+// configMapKeyContent renders the value of the key with its format, the JSON is indented
+func (rD *resourceDict) configMapKeyContent(ctx context.Context, target yamlTarget) ([]byte, error) {
+	keys, err := rD.Controller.ConfigMap.GetKeys(ctx, target.name, target.namespace, target.kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	value, err := rD.Controller.ConfigMap.GetValue(ctx, target.name, target.namespace, target.kubeContext, target.key)
+	if err != nil {
+		return nil, err
+	}
+	format := domain.FormatText
+	for _, key := range keys {
+		if key.Name == target.key {
+			format = key.Format
+		}
+	}
+	content := []byte(value)
+	if format == domain.FormatJSON {
+		var indented bytes.Buffer
+		if json.Indent(&indented, content, "", "  ") == nil {
+			content = indented.Bytes()
+		}
+	}
+	header := fmt.Sprintf("# %s/%s key %s (%s, %s)\n", target.namespace, target.name, target.key, format, formatBytes(int64(len(value))))
+	return append([]byte(header), content...), nil
+}
+
+// configMapReferencesContent renders a line by pod and way it uses the configmap
+func (rD *resourceDict) configMapReferencesContent(ctx context.Context, target yamlTarget) ([]byte, error) {
+	references, err := rD.Controller.ConfigMap.GetReferences(ctx, target.name, target.namespace, target.kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	lines := []string{fmt.Sprintf("# pods using configmap %s/%s", target.namespace, target.name)}
+	if len(references) == 0 {
+		lines = append(lines, "# none")
+	}
+	for _, reference := range references {
+		line := fmt.Sprintf("%s: %s", reference.Pod, reference.Kind)
+		if reference.Container != "" {
+			line += " in container " + reference.Container
+		}
+		if reference.Key != "" {
+			line += ", key " + reference.Key
+		}
+		lines = append(lines, line)
+	}
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// NewConfirmModal creates a modal window asking to confirm an action.
+// With typedName the user must type it before OK confirms.
+// 'onDone' is called with true when the action is confirmed, or with false on cancellation.
+func NewConfirmModal(text, typedName string, onDone func(confirmed bool)) *tview.Grid {
+	form := tview.NewForm()
+	form.SetBackgroundColor(tcell.ColorGray)
+	form.SetBorder(true)
+	form.SetTitle("Confirm")
+
+	form.AddTextView("", text, 70, 2, true, false)
+	height := 8
+	if typedName != "" {
+		form.AddInputField("Type "+typedName, "", 40, nil, nil)
+		height += 2
+	}
+
+	form.AddButton("OK", func() {
+		if typedName != "" {
+			inputField := form.GetFormItem(1).(*tview.InputField)
+			if inputField.GetText() != typedName {
+				inputField.SetLabel("Type " + typedName + " (mismatch)")
+				return
+			}
+		}
+		onDone(true)
+	})
+	form.AddButton("Cancel", func() {
+		onDone(false)
+	})
+
+	grid := tview.NewGrid().
+		SetRows(0, height, 0).
+		SetColumns(0, 80, 0).
+		AddItem(form, 1, 1, 1, 1, 0, 0, true)
+
+	return grid
+}
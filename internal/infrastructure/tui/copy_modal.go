@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Copy directions offered by the copy modal
+const (
+	copyDownload = "Download from container"
+	copyUpload   = "Upload to container"
+)
+
+// NewCopyModal creates a modal window for entering the paths of a copy between the
+// local filesystem and a container. 'onOk' is called with the direction and both paths,
+// or with empty paths on cancellation.
+func NewCopyModal(onOk func(upload bool, remotePath, localPath string)) *tview.Grid {
+	form := tview.NewForm()
+	form.SetBackgroundColor(tcell.ColorGray)
+	form.SetBorder(true)
+	form.SetTitle("Copy Files")
+
+	form.AddDropDown("Direction", []string{copyDownload, copyUpload}, 0, nil)
+	form.AddInputField("Container path", "", 60, nil, nil)
+	form.AddInputField("Local path", ".", 60, nil, nil)
+
+	form.AddButton("OK", func() {
+		_, direction := form.GetFormItem(0).(*tview.DropDown).GetCurrentOption()
+		remotePath := strings.TrimSpace(form.GetFormItem(1).(*tview.InputField).GetText())
+		localPath := expandHome(strings.TrimSpace(form.GetFormItem(2).(*tview.InputField).GetText()))
+		if remotePath == "" || localPath == "" {
+			onOk(false, "", "")
+			return
+		}
+		onOk(direction == copyUpload, remotePath, localPath)
+	})
+	form.AddButton("Cancel", func() {
+		onOk(false, "", "")
+	})
+
+	grid := tview.NewGrid().
+		SetRows(0, 11, 0).
+		SetColumns(0, 90, 0).
+		AddItem(form, 1, 1, 1, 1, 0, 0, true)
+
+	return grid
+}
+
+// expandHome replaces the leading ~ of a path with the home of the user
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// formatBytes returns the size in a human readable unit
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
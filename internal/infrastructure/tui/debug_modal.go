@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// noTargetContainer is the option for a debug container without a shared process namespace
+const noTargetContainer = "(none)"
+
+// NewDebugModal creates a modal window for choosing the image of the debug container
+// and the container whose process namespace is shared. 'onOk' is called with the
+// image and the target, or with an empty image on cancellation.
+func NewDebugModal(images, containers []string, onOk func(image, target string)) *tview.Grid {
+	form := tview.NewForm()
+	form.SetBackgroundColor(tcell.ColorGray)
+	form.SetBorder(true)
+	form.SetTitle("Debug Container")
+
+	targets := append([]string{noTargetContainer}, containers...)
+	initialTarget := 0
+	if len(containers) > 0 {
+		initialTarget = 1
+	}
+
+	form.AddDropDown("Image", images, 0, nil)
+	form.AddInputField("Custom image", "", 40, nil, nil)
+	form.AddDropDown("Target container", targets, initialTarget, nil)
+
+	form.AddButton("OK", func() {
+		_, image := form.GetFormItem(0).(*tview.DropDown).GetCurrentOption()
+		if custom := form.GetFormItem(1).(*tview.InputField).GetText(); custom != "" {
+			image = custom
+		}
+		_, target := form.GetFormItem(2).(*tview.DropDown).GetCurrentOption()
+		if target == noTargetContainer {
+			target = ""
+		}
+		onOk(image, target)
+	})
+	form.AddButton("Cancel", func() {
+		onOk("", "")
+	})
+
+	grid := tview.NewGrid().
+		SetRows(0, 11, 0).
+		SetColumns(0, 80, 0).
+		AddItem(form, 1, 1, 1, 1, 0, 0, true)
+
+	return grid
+}
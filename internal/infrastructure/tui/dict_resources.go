@@ -4,12 +4,14 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"lazykube/internal/adapter/controller"
 	"lazykube/internal/domain"
 	"lazykube/internal/infrastructure/config"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
@@ -35,6 +37,20 @@ type resourceDict struct {
 	Keybinding *KeybindingView
 	LogView    *LogView
 	SetFocus   func(p tview.Primitive)
+	// Screen receives the values copied to the clipboard
+	Screen tcell.Screen
+	// PortForwards is the page of the port-forward manager
+	PortForwards *portForwardsView
+	// Audit is the page of the audit journal
+	Audit *auditView
+	// cancelRefresh stops the refresh of the table in progress, only used from the ui goroutine
+	cancelRefresh context.CancelFunc
+	// yaml is the resource shown in the yaml view, nil when it shows the results of the table
+	yaml *yamlTarget
+	// access caches the access reviews by type, context and namespace, only used from the ui goroutine
+	access map[string]*domain.Access
+	// reviewing marks the access reviews in progress by the same key
+	reviewing map[string]bool
 }
 
 // for singleton
@@ -50,71 +66,110 @@ func NewResourceDict() *resourceDict {
 	return singleInstance
 }
 
-func (rD *resourceDict) showPortForwardForPod(pod domain.Pod) {
-	rD.App.QueueUpdateDraw(func() {
-		modal := NewPortForwardModal(func(ports string) {
-			rD.Pages.RemovePage("portForward")
-			if ports == "" {
-				rD.SetFocus(rD.Table)
-				return
-			}
+// requestContext bounds a request to the clusters with the timeout of the config
+func (rD *resourceDict) requestContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), rD.Config.RequestTimeout())
+}
 
-			if !strings.Contains(ports, ":") {
-				rD.ErrorModal.SetText("Invalid port format. Use local:remote.")
-				rD.Pages.ShowPage("errorModal")
-				return
-			}
+// lister returns the controller of the resource type
+func (rD *resourceDict) lister(typeR string) controller.ResourceLister {
+	switch typeR {
+	case "Deployments":
+		return rD.Controller.Deployment
+	case "Pods":
+		return rD.Controller.Pod
+	case "Services":
+		return rD.Controller.Service
+	case "Secrets":
+		return rD.Controller.Secret
+	case "ConfigMaps":
+		return rD.Controller.ConfigMap
+	case "Jobs":
+		return rD.Controller.Job
+	case "CronJobs":
+		return rD.Controller.CronJob
+	case "StatefulSets":
+		return rD.Controller.StatefulSet
+	case "DaemonSets":
+		return rD.Controller.DaemonSet
+	case "Ingresses":
+		return rD.Controller.Ingress
+	case "HTTPRoutes":
+		return rD.Controller.HTTPRoute
+	case "PersistentVolumeClaims":
+		return rD.Controller.PVC
+	case "PersistentVolumes":
+		return rD.Controller.PV
+	case "HorizontalPodAutoscalers":
+		return rD.Controller.HPA
+	}
+	return nil
+}
 
-			stopChan := make(chan struct{}, 1)
-			readyChan := make(chan struct{}, 1)
+// guard runs the action when the protection of the context allows it, run is
+// called in the ui goroutine. Protected contexts ask for a confirmation, and
+// the destructive actions need the name of the resource typed.
+func (rD *resourceDict) guard(action domain.Action, kubeContext, name string, run func()) {
+	switch rD.Config.ProtectionFor(kubeContext) {
+	case config.ProtectionDenied:
+		rD.ErrorModal.SetText(fmt.Sprintf("Read-only mode: %s is disabled.", action))
+		rD.Pages.ShowPage("errorModal")
+	case config.ProtectionConfirm:
+		rD.confirm(action, fmt.Sprintf("%s is protected. Run %s on %s?", kubeContext, action, name), name, run)
+	default:
+		run()
+	}
+}
 
-			stdout, stderr, err := rD.Controller.Pod.PortForward(context.Background(), pod.Name, pod.Namespace, pod.Context, []string{ports}, stopChan, readyChan)
-			if err != nil {
-				rD.ErrorModal.SetText(fmt.Sprintf("Port forward setup failed: %v", err))
-				rD.Pages.ShowPage("errorModal")
-				return
-			}
+// confirm asks the user before running the action, destructive ones need the name typed
+func (rD *resourceDict) confirm(action domain.Action, text, name string, run func()) {
+	typedName := ""
+	if action.Destructive() {
+		typedName = name
+	}
+	focused := rD.App.GetFocus()
+	modal := NewConfirmModal(text, typedName, func(confirmed bool) {
+		rD.Pages.RemovePage("confirmAction")
+		rD.SetFocus(focused)
+		if confirmed {
+			run()
+		}
+	})
+	rD.Pages.AddPage("confirmAction", modal, true, true)
+	rD.SetFocus(modal)
+}
 
-			go func() {
-				select {
-				case <-readyChan:
-					rD.App.QueueUpdateDraw(func() {
-						time.Sleep(250 * time.Millisecond)
-						modalText := stdout.String()
-						if stderr.Len() > 0 {
-							modalText += "\nErrors:\n" + stderr.String()
-						}
-						if modalText == "" {
-							modalText = "Port forward established. No output from command."
-						}
+// podOwner returns the controller of the type when its resources own pods
+func (rD *resourceDict) podOwner(typeR string) controller.PodOwner {
+	switch typeR {
+	case "Deployments":
+		return rD.Controller.Deployment
+	case "StatefulSets":
+		return rD.Controller.StatefulSet
+	case "DaemonSets":
+		return rD.Controller.DaemonSet
+	case "Jobs":
+		return rD.Controller.Job
+	}
+	return nil
+}
 
-						infoModal := tview.NewModal().
-							SetText(modalText).
-							AddButtons([]string{"Stop"}).
-							SetDoneFunc(func(buttonIndex int, buttonLabel string) {
-								close(stopChan)
-								rD.Pages.HidePage("portForwardInfo")
-								rD.SetFocus(rD.Table)
-							})
-						rD.Pages.AddPage("portForwardInfo", infoModal, true, true)
-						rD.SetFocus(infoModal)
-					})
-				case <-time.After(10 * time.Second):
-					close(stopChan)
-					rD.App.QueueUpdateDraw(func() {
-						rD.ErrorModal.SetText("Port forward timed out.")
-						rD.Pages.ShowPage("errorModal")
-					})
-				}
-			}()
-		})
-		rD.Pages.AddPage("portForward", modal, true, true)
-		rD.SetFocus(modal)
-	})
+// selectPodForWorkload calls onPod in a new goroutine with the pod of the workload,
+// asking the user which one when there are many
+func (rD *resourceDict) selectPodForWorkload(typeR, workloadName, namespace, contextStr string, onPod func(pod domain.Pod)) {
+	owner := rD.podOwner(typeR)
+	if owner == nil {
+		return
+	}
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	pods, err := owner.GetPods(ctx, workloadName, namespace, contextStr)
+	rD.selectPod(pods, err, strings.ToLower(strings.TrimSuffix(typeR, "s")), onPod)
 }
 
-func (rD *resourceDict) showPortForwardForDeployment(deploymentName, namespace, contextStr string) {
-	pods, err := rD.Controller.Deployment.GetPods(context.Background(), deploymentName, namespace, contextStr)
+// selectPod calls onPod in a new goroutine with the pod of the owner, asking the user
+// which one when there are many. err is the error getting the pods.
+func (rD *resourceDict) selectPod(pods []domain.Pod, err error, owner string, onPod func(pod domain.Pod)) {
 	rD.App.QueueUpdateDraw(func() {
 		if err != nil {
 			rD.ErrorModal.SetText(fmt.Sprintf("Failed to get pods: %v", err))
@@ -123,20 +178,20 @@ func (rD *resourceDict) showPortForwardForDeployment(deploymentName, namespace,
 		}
 
 		if len(pods) == 0 {
-			rD.ErrorModal.SetText("No pods found for this deployment.")
+			rD.ErrorModal.SetText(fmt.Sprintf("No pods found for this %s.", owner))
 			rD.Pages.ShowPage("errorModal")
 			return
 		}
 
 		if len(pods) == 1 {
-			go rD.showPortForwardForPod(pods[0])
+			go onPod(pods[0])
 			return
 		}
 
 		modal, list := NewPodSelectionModal(pods, func(pod domain.Pod) {
 			rD.Pages.RemovePage("podSelection")
 			if pod.Name != "" {
-				go rD.showPortForwardForPod(pod)
+				go onPod(pod)
 			} else {
 				rD.SetFocus(rD.Table)
 			}
@@ -146,6 +201,165 @@ func (rD *resourceDict) showPortForwardForDeployment(deploymentName, namespace,
 	})
 }
 
+// showPortForwardForPod asks for the ports and starts a session in the port-forward manager.
+// deploymentName is empty when the pod wasn't chosen from a deployment.
+func (rD *resourceDict) showPortForwardForPod(pod domain.Pod, deploymentName string) {
+	// The pods of the table don't have the spec, the declared ports come from it
+	if len(pod.Containers) == 0 {
+		ctx, cancel := rD.requestContext()
+		defer cancel()
+		if fullPod, err := rD.Controller.Pod.GetPod(ctx, pod.Name, pod.Namespace, pod.Context); err == nil {
+			pod = *fullPod
+		}
+	}
+	choices := podPortChoices(pod)
+
+	rD.App.QueueUpdateDraw(func() {
+		modal := NewPortForwardModal(choices, deploymentName != "", func(text string, reconnect bool) {
+			rD.Pages.RemovePage("portForward")
+			ports := parsePortMappings(text)
+			if len(ports) == 0 {
+				rD.SetFocus(rD.Table)
+				return
+			}
+
+			for _, mapping := range ports {
+				if err := validatePortMapping(mapping); err != nil {
+					rD.ErrorModal.SetText(err.Error())
+					rD.Pages.ShowPage("errorModal")
+					return
+				}
+			}
+
+			target := domain.PortForwardTarget{
+				Context:    pod.Context,
+				Namespace:  pod.Namespace,
+				Pod:        pod.Name,
+				Deployment: deploymentName,
+			}
+			if err := rD.startPortForward(target, ports, reconnect); err != nil {
+				rD.ErrorModal.SetText(fmt.Sprintf("Port forward setup failed: %v", err))
+				rD.Pages.ShowPage("errorModal")
+				return
+			}
+			rD.showPortForwards()
+		})
+		rD.Pages.AddPage("portForward", modal, true, true)
+		rD.SetFocus(modal)
+	})
+}
+
+// showPortForwardForWorkload forwards to a pod of the workload, only the sessions of
+// a deployment can move to a new pod when it is replaced
+func (rD *resourceDict) showPortForwardForWorkload(typeR, workloadName, namespace, contextStr string) {
+	rD.selectPodForWorkload(typeR, workloadName, namespace, contextStr, func(pod domain.Pod) {
+		deploymentName := ""
+		if typeR == "Deployments" {
+			deploymentName = workloadName
+		}
+		rD.showPortForwardForPod(pod, deploymentName)
+	})
+}
+
+// showPortForwardForService asks for the ports of the service and starts a session
+// on a ready pod of it. The remote port is a port number or a port name of the service.
+func (rD *resourceDict) showPortForwardForService(serviceName, namespace, contextStr string) {
+	rD.App.QueueUpdateDraw(func() {
+		modal := NewPortForwardModal(nil, true, func(text string, reconnect bool) {
+			rD.Pages.RemovePage("portForward")
+			ports := parsePortMappings(text)
+			if len(ports) == 0 {
+				rD.SetFocus(rD.Table)
+				return
+			}
+
+			target := domain.PortForwardTarget{
+				Context:   contextStr,
+				Namespace: namespace,
+				Service:   serviceName,
+			}
+			if err := rD.startPortForward(target, ports, reconnect); err != nil {
+				rD.ErrorModal.SetText(fmt.Sprintf("Port forward setup failed: %v", err))
+				rD.Pages.ShowPage("errorModal")
+				return
+			}
+			rD.showPortForwards()
+		})
+		rD.Pages.AddPage("portForward", modal, true, true)
+		rD.SetFocus(modal)
+	})
+}
+
+// showPortForwardProfiles lets the user choose a profile of the config and starts all its port-forwards
+func (rD *resourceDict) showPortForwardProfiles() {
+	if rD.Config.ReadOnly {
+		rD.ErrorModal.SetText(fmt.Sprintf("Read-only mode: %s is disabled.", domain.ActionPortForward))
+		rD.Pages.ShowPage("errorModal")
+		return
+	}
+	if len(rD.Config.PortForwardProfiles) == 0 {
+		rD.ErrorModal.SetText("No port-forward profiles in the config.")
+		rD.Pages.ShowPage("errorModal")
+		return
+	}
+	modal, list := NewProfileSelectionModal(rD.Config.PortForwardProfiles, func(profile config.PortForwardProfile) {
+		rD.Pages.RemovePage("profileSelection")
+		rD.showPortForwards()
+		if profile.Name == "" {
+			return
+		}
+		// Every context of the profile is checked, a disabled one refuses the whole profile
+		contexts := []string{}
+		for _, entry := range profile.Forwards {
+			contexts = append(contexts, entry.Context)
+		}
+		switch protection, matched := rD.Config.StrictestProtection(contexts); protection {
+		case config.ProtectionDenied:
+			rD.ErrorModal.SetText(fmt.Sprintf("Read-only mode: %s is disabled on %s.", domain.ActionPortForward, strings.Join(matched, ", ")))
+			rD.Pages.ShowPage("errorModal")
+		case config.ProtectionConfirm:
+			text := fmt.Sprintf("Protected contexts: %s. Run %s on profile %s?", strings.Join(matched, ", "), domain.ActionPortForward, profile.Name)
+			rD.confirm(domain.ActionPortForward, text, "profile "+profile.Name, func() {
+				rD.startPortForwardProfile(profile)
+			})
+		default:
+			rD.startPortForwardProfile(profile)
+		}
+	})
+	rD.Pages.AddPage("profileSelection", modal, true, true)
+	rD.SetFocus(list)
+}
+
+// startPortForwardProfile starts every port-forward of the profile
+func (rD *resourceDict) startPortForwardProfile(profile config.PortForwardProfile) {
+	failed := []string{}
+	for _, entry := range profile.Forwards {
+		if err := rD.startPortForward(entry.Target(), entry.Ports, entry.Reconnect); err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	rD.PortForwards.Refresh()
+	if len(failed) > 0 {
+		rD.ErrorModal.SetText(fmt.Sprintf("Profile %s: %s", profile.Name, strings.Join(failed, "\n")))
+		rD.Pages.ShowPage("errorModal")
+	}
+}
+
+// showPortForwards brings the port-forward manager to the front
+func (rD *resourceDict) showPortForwards() {
+	rD.PortForwards.Refresh()
+	rD.Pages.ShowPage("portForwards")
+	rD.SetFocus(rD.PortForwards)
+	rD.PortForwards.StartTicking()
+}
+
+// showAudit brings the audit journal to the front
+func (rD *resourceDict) showAudit() {
+	rD.Audit.Refresh()
+	rD.Pages.ShowPage("audit")
+	rD.SetFocus(rD.Audit)
+}
+
 func (rD *resourceDict) showLogsForPod(pod domain.Pod, containerName string) {
 	var getLogsFn func(string)
 	getLogsFn = func(cName string) {
@@ -208,41 +422,64 @@ func (rD *resourceDict) showLogsForPod(pod domain.Pod, containerName string) {
 	getLogsFn(containerName)
 }
 
-func (rD *resourceDict) showLogsForDeployment(deploymentName, namespace, contextStr string) {
-	pods, err := rD.Controller.Deployment.GetPods(context.Background(), deploymentName, namespace, contextStr)
-	rD.App.QueueUpdateDraw(func() {
-		if err != nil {
-			rD.ErrorModal.SetText(fmt.Sprintf("Failed to get pods: %v", err))
-			rD.Pages.ShowPage("errorModal")
-			return
-		}
+func (rD *resourceDict) showLogsForWorkload(typeR, workloadName, namespace, contextStr string) {
+	rD.selectPodForWorkload(typeR, workloadName, namespace, contextStr, func(pod domain.Pod) {
+		rD.showLogsForPod(pod, "")
+	})
+}
 
-		if len(pods) == 0 {
-			rD.ErrorModal.SetText("No pods found for this deployment.")
-			rD.Pages.ShowPage("errorModal")
-			return
+// showExecForPod opens a terminal in the container. With a nil command the default
+// of the config is used, and if there is none the first shell found in the container.
+func (rD *resourceDict) showExecForPod(pod domain.Pod, containerName string, command []string) {
+	session := func(cName string, streamOptions remotecommand.StreamOptions) error {
+		cmd := command
+		if cmd == nil {
+			ctx, cancel := rD.requestContext()
+			if fullPod, podErr := rD.Controller.Pod.GetPod(ctx, pod.Name, pod.Namespace, pod.Context); podErr == nil {
+				cmd = rD.Config.Exec.CommandFor(*fullPod, cName)
+			}
+			cancel()
 		}
-
-		if len(pods) == 1 {
-			go rD.showLogsForPod(pods[0], "")
-			return
+		// The session is recorded as started, and again with its result when it ends
+		args := func(cmd []string) []string {
+			return append([]string{"exec", "-it", pod.Name, "-c", cName, "--"}, cmd...)
 		}
-
-		modal, list := NewPodSelectionModal(pods, func(pod domain.Pod) {
-			rD.Pages.RemovePage("podSelection")
-			if pod.Name != "" {
-				go rD.showLogsForPod(pod, "")
-			} else {
-				rD.SetFocus(rD.Table)
-			}
+		if cmd != nil {
+			rD.recordStarted(domain.ActionExec, pod.Context, pod.Namespace, "pod/"+pod.Name, args(cmd))
+			err := rD.Controller.Pod.Exec(context.Background(), pod.Name, pod.Namespace, pod.Context, cmd, cName, false, streamOptions)
+			rD.record(domain.ActionExec, pod.Context, pod.Namespace, "pod/"+pod.Name, args(cmd), err)
+			return err
+		}
+		shells := rD.Config.Exec.GetShells()
+		shell, err := rD.Controller.Pod.ExecShell(context.Background(), pod.Name, pod.Namespace, pod.Context, shells, cName, streamOptions, func(shell string) {
+			rD.recordStarted(domain.ActionExec, pod.Context, pod.Namespace, "pod/"+pod.Name, args([]string{shell}))
 		})
-		rD.Pages.AddPage("podSelection", modal, true, true)
-		rD.SetFocus(list)
-	})
-}
+		// Without a shell found the first one tried is recorded
+		if shell == "" && len(shells) > 0 {
+			shell = shells[0]
+		}
+		rD.record(domain.ActionExec, pod.Context, pod.Namespace, "pod/"+pod.Name, args([]string{shell}), err)
+		return err
+	}
 
-func (rD *resourceDict) showExecForPod(pod domain.Pod, containerName string) {
 	showTerminal := func(cName string) {
+		if rD.Config.Exec.Suspend() {
+			go func() {
+				err := rD.runSuspended(func(streamOptions remotecommand.StreamOptions) error {
+					return session(cName, streamOptions)
+				})
+				rD.App.QueueUpdateDraw(func() {
+					if err != nil {
+						rD.ErrorModal.SetText(err.Error())
+						rD.Pages.ShowPage("errorModal")
+						return
+					}
+					rD.SetFocus(rD.Table)
+				})
+			}()
+			return
+		}
+
 		terminalPageName := fmt.Sprintf("terminal-%s-%s", pod.Name, cName)
 		closeFn := func() {
 			rD.Pages.RemovePage(terminalPageName)
@@ -253,7 +490,7 @@ func (rD *resourceDict) showExecForPod(pod domain.Pod, containerName string) {
 
 		go func() {
 			defer closeFn()
-			err := rD.Controller.Pod.Exec(context.Background(), pod.Name, pod.Namespace, pod.Context, "sh", cName, false, streamOptions)
+			err := session(cName, streamOptions)
 			if err != nil {
 				rD.App.QueueUpdateDraw(func() {
 					rD.ErrorModal.SetText(err.Error())
@@ -265,15 +502,23 @@ func (rD *resourceDict) showExecForPod(pod domain.Pod, containerName string) {
 		rD.SetFocus(terminalView)
 	}
 
-	// This first Exec call is a dry run to get container names
-	err := rD.Controller.Pod.Exec(context.Background(), pod.Name, pod.Namespace, pod.Context, "sh", containerName, true, remotecommand.StreamOptions{})
+	rD.selectContainer(pod, containerName, showTerminal)
+}
+
+// selectContainer calls onContainer in the ui goroutine with the container of the pod,
+// asking the user which one when the pod has many
+func (rD *resourceDict) selectContainer(pod domain.Pod, containerName string, onContainer func(container string)) {
+	// This Exec call is a dry run to get container names
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	err := rD.Controller.Pod.Exec(ctx, pod.Name, pod.Namespace, pod.Context, nil, containerName, true, remotecommand.StreamOptions{})
 	rD.App.QueueUpdateDraw(func() {
 		if err != nil {
 			if containerErr, ok := err.(*domain.ContainerSelectionError); ok {
 				modal, list := NewContainerSelectionModal(containerErr.Containers, func(container string) {
 					rD.Pages.RemovePage("containerSelection")
 					if container != "" {
-						showTerminal(container)
+						onContainer(container)
 					} else {
 						rD.SetFocus(rD.Table)
 					}
@@ -285,53 +530,295 @@ func (rD *resourceDict) showExecForPod(pod domain.Pod, containerName string) {
 				rD.Pages.ShowPage("errorModal")
 			}
 		} else {
-			showTerminal(containerName)
+			onContainer(containerName)
 		}
 	})
 }
 
-func (rD *resourceDict) showExecForDeployment(deploymentName, namespace, contextStr string) {
-	pods, err := rD.Controller.Deployment.GetPods(context.Background(), deploymentName, namespace, contextStr)
+// showCopyForPod asks for the paths and copies files between the local filesystem and the container
+func (rD *resourceDict) showCopyForPod(pod domain.Pod) {
 	rD.App.QueueUpdateDraw(func() {
-		if err != nil {
-			rD.ErrorModal.SetText(fmt.Sprintf("Failed to get pods: %v", err))
-			rD.Pages.ShowPage("errorModal")
+		modal := NewCopyModal(func(upload bool, remotePath, localPath string) {
+			rD.Pages.RemovePage("copy")
+			if remotePath == "" {
+				rD.SetFocus(rD.Table)
+				return
+			}
+			go rD.selectContainer(pod, "", func(container string) {
+				rD.runCopy(pod, container, upload, remotePath, localPath)
+			})
+		})
+		rD.Pages.AddPage("copy", modal, true, true)
+		rD.SetFocus(modal)
+	})
+}
+
+// runCopy shows the progress of the copy in a modal that allows to cancel it
+func (rD *resourceDict) runCopy(pod domain.Pod, container string, upload bool, remotePath, localPath string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	description := fmt.Sprintf("Downloading %s:%s to %s", pod.Name, remotePath, localPath)
+	if upload {
+		description = fmt.Sprintf("Uploading %s to %s:%s", localPath, pod.Name, remotePath)
+	}
+
+	progressModal := tview.NewModal().
+		SetText(description + "\n\nStarting...").
+		AddButtons([]string{"Cancel"})
+	progressModal.SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+		cancel()
+		rD.Pages.RemovePage("copyProgress")
+		rD.SetFocus(rD.Table)
+	})
+	rD.Pages.AddPage("copyProgress", progressModal, true, true)
+	rD.SetFocus(progressModal)
+
+	// The transfer counts in the goroutine of the stream, the total is read when it ends
+	var total atomic.Int64
+	var lastUpdate time.Time
+	progress := func(n int64) {
+		total.Store(n)
+		if ctx.Err() != nil || time.Since(lastUpdate) < 200*time.Millisecond {
 			return
 		}
+		lastUpdate = time.Now()
+		rD.App.QueueUpdateDraw(func() {
+			progressModal.SetText(fmt.Sprintf("%s\n\n%s transferred", description, formatBytes(n)))
+		})
+	}
 
-		if len(pods) == 0 {
-			rD.ErrorModal.SetText("No pods found for this deployment.")
-			rD.Pages.ShowPage("errorModal")
+	go func() {
+		var err error
+		if upload {
+			err = rD.Controller.Pod.CopyTo(ctx, pod.Name, pod.Namespace, pod.Context, container, localPath, remotePath, progress)
+		} else {
+			err = rD.Controller.Pod.CopyFrom(ctx, pod.Name, pod.Namespace, pod.Context, container, remotePath, localPath, progress)
+		}
+		args := []string{"cp", pod.Name + ":" + remotePath, localPath, "-c", container}
+		if upload {
+			args = []string{"cp", localPath, pod.Name + ":" + remotePath, "-c", container}
+		}
+		if ctx.Err() != nil {
+			// Cancelled by the user
+			rD.record(domain.ActionCopy, pod.Context, pod.Namespace, "pod/"+pod.Name, args, ctx.Err())
 			return
 		}
+		// A late write of the stream doesn't redraw the progress over the result
+		cancel()
+		rD.record(domain.ActionCopy, pod.Context, pod.Namespace, "pod/"+pod.Name, args, err)
+		rD.App.QueueUpdateDraw(func() {
+			if err != nil {
+				progressModal.SetText(fmt.Sprintf("%s\n\nFailed: %v", description, err))
+			} else {
+				progressModal.SetText(fmt.Sprintf("%s\n\nDone, %s transferred", description, formatBytes(total.Load())))
+			}
+			progressModal.ClearButtons().AddButtons([]string{"OK"})
+		})
+	}()
+}
 
-		if len(pods) == 1 {
-			go rD.showExecForPod(pods[0], "")
+func (rD *resourceDict) showCopyForWorkload(typeR, workloadName, namespace, contextStr string) {
+	rD.selectPodForWorkload(typeR, workloadName, namespace, contextStr, func(pod domain.Pod) {
+		rD.showCopyForPod(pod)
+	})
+}
+
+func (rD *resourceDict) showExecForWorkload(typeR, workloadName, namespace, contextStr string, command []string) {
+	rD.selectPodForWorkload(typeR, workloadName, namespace, contextStr, func(pod domain.Pod) {
+		rD.showExecForPod(pod, "", command)
+	})
+}
+
+// showDebugForPod adds an ephemeral debug container to the pod and opens a session in it
+func (rD *resourceDict) showDebugForPod(pod domain.Pod) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	fullPod, err := rD.Controller.Pod.GetPod(ctx, pod.Name, pod.Namespace, pod.Context)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
 			return
 		}
 
-		modal, list := NewPodSelectionModal(pods, func(pod domain.Pod) {
-			rD.Pages.RemovePage("podSelection")
-			if pod.Name != "" {
-				go rD.showExecForPod(pod, "")
-			} else {
+		containers := []string{}
+		for _, container := range fullPod.Containers {
+			containers = append(containers, container.Name)
+		}
+
+		modal := NewDebugModal(rD.Config.Debug.GetImages(), containers, func(image, target string) {
+			rD.Pages.RemovePage("debug")
+			if image == "" {
 				rD.SetFocus(rD.Table)
+				return
 			}
+			rD.View.SetText(fmt.Sprintf("Starting debug container with image %s in pod %s...", image, pod.Name))
+			rD.SetFocus(rD.Table)
+
+			go func() {
+				containerName, err := rD.Controller.Pod.Debug(context.Background(), pod.Name, pod.Namespace, pod.Context, image, target)
+				args := []string{"debug", pod.Name, "-it", "--image", image}
+				if target != "" {
+					args = append(args, "--target", target)
+				}
+				rD.record(domain.ActionDebug, pod.Context, pod.Namespace, "pod/"+pod.Name, args, err)
+				if err != nil {
+					rD.App.QueueUpdateDraw(func() {
+						rD.ErrorModal.SetText(err.Error())
+						rD.Pages.ShowPage("errorModal")
+					})
+					return
+				}
+				rD.showExecForPod(pod, containerName, nil)
+			}()
 		})
-		rD.Pages.AddPage("podSelection", modal, true, true)
-		rD.SetFocus(list)
+		rD.Pages.AddPage("debug", modal, true, true)
+		rD.SetFocus(modal)
+	})
+}
+
+func (rD *resourceDict) showDebugForWorkload(typeR, workloadName, namespace, contextStr string) {
+	rD.selectPodForWorkload(typeR, workloadName, namespace, contextStr, func(pod domain.Pod) {
+		rD.showDebugForPod(pod)
+	})
+}
+
+// showExecCommandPrompt asks for a command line and runs it with onCommand
+func (rD *resourceDict) showExecCommandPrompt(onCommand func(command []string)) {
+	rD.App.QueueUpdateDraw(func() {
+		modal := NewCommandModal(func(line string) {
+			rD.Pages.RemovePage("execCommand")
+			if strings.TrimSpace(line) == "" {
+				rD.SetFocus(rD.Table)
+				return
+			}
+			command, err := splitCommandLine(line)
+			if err != nil {
+				rD.ErrorModal.SetText(err.Error())
+				rD.Pages.ShowPage("errorModal")
+				return
+			}
+			go onCommand(command)
+		})
+		rD.Pages.AddPage("execCommand", modal, true, true)
+		rD.SetFocus(modal)
 	})
 }
 
-// The function for fill the resource table, used for many items
+// resourceQuery is what the table shows, the auto-refresh repeats it
+type resourceQuery struct {
+	typeR      string
+	contexts   []string
+	namespaces []string
+	filter     string
+}
+
+// yamlTarget is the resource shown in the yaml view
+type yamlTarget struct {
+	typeR, namespace, name, kubeContext string
+	// mode is what is shown of the resource, the manifest by default
+	mode yamlMode
+	// key is the key of the configmap shown in yamlModeKey
+	key string
+}
+
+type yamlMode string
+
+const (
+	yamlModeManifest yamlMode = ""
+	// yamlModeDecoded shows the values of a secret instead of masking them
+	yamlModeDecoded yamlMode = "decoded"
+	// yamlModeKey shows the value of one key of a configmap
+	yamlModeKey yamlMode = "key"
+	// yamlModeReferences shows the pods that use a configmap
+	yamlModeReferences yamlMode = "references"
+	// yamlModeAutoscaler describes the autoscaler of a workload or an autoscaler
+	yamlModeAutoscaler yamlMode = "autoscaler"
+)
+
+// The function for fill the resource table, used for many items.
+// The resources are loaded in background and a new refresh cancels the one in progress.
 func (rD *resourceDict) UpdateResources() {
 	// Get the info from lists and filter
-	contexts := rD.Menu.GetTextSelectedItems()
-	namespaces := rD.Namespace.GetTextSelectedItems()
 	typeR, _ := rD.Type.GetItemText(rD.Type.GetCurrentItem())
-	filter := rD.Filter.GetText()
+	query := resourceQuery{
+		typeR:      typeR,
+		contexts:   rD.Menu.GetTextSelectedItems(),
+		namespaces: rD.Namespace.GetSelectedNamespaces(),
+		filter:     rD.Filter.GetText(),
+	}
+	// The yaml view goes back to the results of the table, and the permissions are reviewed again
+	rD.yaml = nil
+	rD.access = map[string]*domain.Access{}
+	rD.reviewing = map[string]bool{}
+	if rD.refresh(query) {
+		rD.SetFocus(rD.Table)
+	}
+}
+
+// refresh loads the resources of the query in background, it reports false
+// when the type can't be listed
+func (rD *resourceDict) refresh(query resourceQuery) bool {
+	lister := rD.lister(query.typeR)
+	if lister == nil {
+		return false
+	}
+
+	rD.CancelRefresh()
+	ctx, cancel := rD.requestContext()
+	rD.cancelRefresh = cancel
+	stopLoading := rD.Table.StartLoading(rD.App)
+
+	go func() {
+		defer cancel()
+		results, err := lister.GetFromManyContext(ctx, query.namespaces, query.contexts)
+		rD.App.QueueUpdateDraw(func() {
+			stopLoading()
+			if ctx.Err() == context.Canceled {
+				// A newer refresh or a new selection replaced this one
+				return
+			}
+			rD.cancelRefresh = nil
+			rD.Table.query = &query
+			rD.Table.lastUpdated = time.Now()
+			rD.fillResources(query.typeR, query.filter, results, err)
+		})
+	}()
+	return true
+}
+
+// autoRefresh repeats the query of the table, and reloads the yaml view, when
+// the interval of the type has passed. It runs in the ui goroutine.
+func (rD *resourceDict) autoRefresh() {
+	query := rD.Table.query
+	if query == nil || rD.Table.paused || rD.cancelRefresh != nil {
+		return
+	}
+	interval := rD.Config.Refresh.IntervalFor(query.typeR)
+	if interval == 0 || time.Since(rD.Table.lastUpdated) < interval {
+		return
+	}
+	// Don't change what the user has in front, like a modal or the port-forwards page
+	if name, _ := rD.Pages.GetFrontPage(); name != "main" {
+		return
+	}
+	rD.refresh(*query)
+	if rD.yaml != nil {
+		rD.loadYaml(*rD.yaml, false)
+	}
+}
 
+// CancelRefresh stops the refresh of the table in progress
+func (rD *resourceDict) CancelRefresh() {
+	if rD.cancelRefresh != nil {
+		rD.cancelRefresh()
+		rD.cancelRefresh = nil
+	}
+}
+
+// fillResources shows the results of a refresh in the table
+func (rD *resourceDict) fillResources(typeR, filter string, results map[string][]map[string]string, err error) {
 	// The table must know the type of resource that show
+	row, _ := rD.Table.GetSelection()
 	rD.Table.ResourceType = typeR
 	rD.Table.Clear()
 
@@ -339,40 +826,109 @@ func (rD *resourceDict) UpdateResources() {
 	rD.Table.SetCell(0, 0, tview.NewTableCell("NAME").SetSelectable(false))
 	rD.Table.SetCell(0, 1, tview.NewTableCell("NAMESPACE").SetSelectable(false))
 	rD.Table.SetCell(0, 2, tview.NewTableCell("CLUSTER").SetSelectable(false))
-	results := map[string][]map[string]string{}
-	switch typeR {
-	case "Deployments":
-		results, _ = rD.Controller.Deployment.GetFromManyContext(context.Background(), namespaces, contexts)
-	case "Pods":
-		results, _ = rD.Controller.Pod.GetFromManyContext(context.Background(), namespaces, contexts)
+	columns := resourceColumns[typeR]
+	for i, column := range columns {
+		rD.Table.SetCell(0, 3+i, tview.NewTableCell(column.Header).SetSelectable(false))
 	}
 
-	// Fill the resource table, depends if have or not a filter
+	// The failing contexts and namespaces go first, the data of the others is still shown
 	c := 1
-	if filter == "" {
-		for cluster, res := range results {
-			for _, data := range res {
-				rD.Table.SetCell(c, 0, tview.NewTableCell(data["name"]))
-				rD.Table.SetCell(c, 1, tview.NewTableCell(data["namespace"]))
-				rD.Table.SetCell(c, 2, tview.NewTableCell(cluster))
-				c++
-			}
+	rD.Table.Errors = map[int]domain.ContextError{}
+	var multiErr *domain.MultiContextError
+	if errors.As(err, &multiErr) {
+		for _, contextErr := range multiErr.Errors {
+			rD.Table.setErrorRow(c, contextErr)
+			c++
 		}
-	} else {
-		for cluster, res := range results {
-			for _, data := range res {
-				if strings.Contains(data["name"], filter) {
-					rD.Table.SetCell(c, 0, tview.NewTableCell(data["name"]))
-					rD.Table.SetCell(c, 1, tview.NewTableCell(data["namespace"]))
-					rD.Table.SetCell(c, 2, tview.NewTableCell(cluster))
-					c++
+	} else if err != nil {
+		rD.ErrorModal.SetText(err.Error())
+		rD.Pages.ShowPage("errorModal")
+	}
+
+	// Fill the resource table, depends if have or not a filter
+	for cluster, res := range results {
+		for _, data := range res {
+			if filter != "" && !strings.Contains(data["name"], filter) {
+				continue
+			}
+			rD.Table.SetCell(c, 0, tview.NewTableCell(data["name"]))
+			rD.Table.SetCell(c, 1, tview.NewTableCell(data["namespace"]))
+			rD.Table.SetCell(c, 2, tview.NewTableCell(cluster))
+			for i, column := range columns {
+				rD.Table.SetCell(c, 3+i, tview.NewTableCell(data[column.Key]))
+			}
+			// A highlighted row needs attention, like a pending claim
+			if data["highlight"] != "" {
+				for col := 0; col < 3+len(columns); col++ {
+					rD.Table.GetCell(c, col).SetTextColor(tcell.ColorYellow)
 				}
 			}
+			c++
 		}
 	}
-	jsonResult, _ := json.MarshalIndent(results, "", " ")
-	rD.View.SetText(string(jsonResult))
-	rD.SetFocus(rD.Table)
+	if row > 0 && row < rD.Table.GetRowCount() {
+		rD.Table.Select(row, 0)
+	}
+	rD.updateResourceKeys()
+	if rD.yaml == nil {
+		jsonResult, _ := json.MarshalIndent(results, "", " ")
+		rD.View.SetText(string(jsonResult))
+	}
+}
+
+// showYaml loads the yaml of the resource in background and shows it in the yaml view
+func (rD *resourceDict) showYaml(typeR, namespace, name, kubeContext string) {
+	target := yamlTarget{typeR: typeR, namespace: namespace, name: name, kubeContext: kubeContext}
+	rD.yaml = &target
+	rD.loadYaml(target, true)
+}
+
+func (rD *resourceDict) loadYaml(target yamlTarget, focus bool) {
+	lister := rD.lister(target.typeR)
+	if lister == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := rD.requestContext()
+		defer cancel()
+		results, err := rD.yamlContent(ctx, lister, target)
+		rD.App.QueueUpdateDraw(func() {
+			if rD.yaml == nil || *rD.yaml != target {
+				// The view shows something else now
+				return
+			}
+			if err != nil {
+				if focus {
+					rD.View.Clear()
+					rD.ErrorModal.SetText(err.Error())
+					rD.Pages.ShowPage("errorModal")
+				}
+				return
+			}
+			row, col := rD.View.GetScrollOffset()
+			rD.View.SetText(string(results))
+			if focus {
+				rD.SetFocus(rD.View)
+			} else {
+				rD.View.ScrollTo(row, col)
+			}
+		})
+	}()
+}
+
+// yamlContent loads what the yaml view shows for the target
+func (rD *resourceDict) yamlContent(ctx context.Context, lister controller.ResourceLister, target yamlTarget) ([]byte, error) {
+	switch target.mode {
+	case yamlModeDecoded:
+		return rD.Controller.Secret.GetDecoded(ctx, target.name, target.namespace, target.kubeContext)
+	case yamlModeKey:
+		return rD.configMapKeyContent(ctx, target)
+	case yamlModeReferences:
+		return rD.configMapReferencesContent(ctx, target)
+	case yamlModeAutoscaler:
+		return rD.autoscalerContent(ctx, target)
+	}
+	return lister.GetYaml(ctx, target.namespace, target.name, target.kubeContext)
 }
 
 // The event keys for all the list
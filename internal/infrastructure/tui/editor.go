@@ -0,0 +1,67 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// editorCommand returns the editor of the user, $VISUAL before $EDITOR
+func editorCommand() []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return []string{"notepad"}
+	}
+	return []string{"vi"}
+}
+
+// editInEditor opens the content in the editor of the user with the tui
+// suspended, and returns it with the path of the temporary file when the
+// editor exits. The caller removes the file once the content is saved, so
+// the edit isn't lost when it is refused. The extension of the temporary file
+// lets the editor highlight the syntax.
+func (rD *resourceDict) editInEditor(content []byte, extension string) ([]byte, string, error) {
+	file, err := os.CreateTemp("", "lazykube-*"+extension)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, "", err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return nil, "", err
+	}
+
+	editor := editorCommand()
+	var runErr error
+	suspended := rD.App.Suspend(func() {
+		cmd := exec.Command(editor[0], append(editor[1:], file.Name())...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		runErr = cmd.Run()
+	})
+	if !suspended {
+		os.Remove(file.Name())
+		return nil, "", fmt.Errorf("failed to suspend the application")
+	}
+	if runErr != nil {
+		os.Remove(file.Name())
+		return nil, "", fmt.Errorf("editor %s failed: %w", editor[0], runErr)
+	}
+	edited, err := os.ReadFile(file.Name())
+	if err != nil {
+		os.Remove(file.Name())
+		return nil, "", err
+	}
+	return edited, file.Name(), nil
+}
@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"log/slog"
+
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -25,3 +27,9 @@ func NewErrorModal(dict *resourceDict) *errorModal {
 		modal,
 	}
 }
+
+// SetText shows the error and records it in the log
+func (eM *errorModal) SetText(text string) *tview.Modal {
+	slog.Warn("error shown", "text", text)
+	return eM.Modal.SetText(text)
+}
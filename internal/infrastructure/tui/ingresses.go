@@ -0,0 +1,154 @@
+package tui
+
+import (
+	"fmt"
+	"lazykube/internal/domain"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var routeHeaders = []string{"HOST", "PATH", "SERVICE", "PORT", "BACKEND"}
+
+var tlsHeaders = []string{"TLS SECRET", "HOSTS", "EXPIRES"}
+
+// showIngressRouting resolves the routes of the ingress or the httproute, Enter on
+// a route shows the endpoints of its service
+func (rD *resourceDict) showIngressRouting(typeR, namespace, name, kubeContext string) {
+	ingressController := rD.Controller.Ingress
+	if typeR == "HTTPRoutes" {
+		ingressController = rD.Controller.HTTPRoute
+	}
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	routing, err := ingressController.GetRouting(ctx, name, namespace, kubeContext)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		modal, table := NewRoutingModal(fmt.Sprintf("Routes of %s/%s", namespace, name), routing, time.Now(), func(route *domain.RouteStatus) {
+			rD.Pages.RemovePage("routing")
+			rD.SetFocus(rD.Table)
+			if route != nil {
+				go rD.showServiceEndpoints(route.Namespace, route.Service, kubeContext)
+			}
+		})
+		rD.Pages.AddPage("routing", modal, true, true)
+		rD.SetFocus(table)
+	})
+}
+
+// NewRoutingModal creates a modal with the routes and the TLS certificates of an ingress.
+// 'onDone' is called with the route chosen for its endpoints, or with nil when closed.
+func NewRoutingModal(title string, routing *domain.IngressRouting, now time.Time, onDone func(route *domain.RouteStatus)) (*tview.Flex, *tview.Table) {
+	table := tview.NewTable()
+	table.SetSelectable(true, false)
+	table.SetFixed(1, 0)
+	table.SetBorder(true)
+	table.SetTitle(title + " (Enter: endpoints, Esc: close)")
+
+	for col, header := range routeHeaders {
+		table.SetCell(0, col, tview.NewTableCell(header).SetSelectable(false))
+	}
+	if len(routing.Routes) == 0 {
+		table.SetCell(1, 0, tview.NewTableCell("No routes to a service").SetSelectable(false))
+	}
+	for i, route := range routing.Routes {
+		r := i + 1
+		backend, color := routeBackend(route)
+		service := route.Service
+		if route.Namespace != "" {
+			service = route.Namespace + "/" + service
+		}
+		table.SetCell(r, 0, tview.NewTableCell(route.Host))
+		table.SetCell(r, 1, tview.NewTableCell(route.Path))
+		table.SetCell(r, 2, tview.NewTableCell(service))
+		table.SetCell(r, 3, tview.NewTableCell(route.Port))
+		table.SetCell(r, 4, tview.NewTableCell(backend).SetTextColor(color))
+	}
+
+	if len(routing.TLS) > 0 {
+		r := max(len(routing.Routes), 1) + 2
+		for col, header := range tlsHeaders {
+			table.SetCell(r, col, tview.NewTableCell(header).SetSelectable(false))
+		}
+		for i, tls := range routing.TLS {
+			expiry, color := tlsExpiry(tls, now)
+			secret := tls.Secret
+			if tls.Namespace != "" {
+				secret = tls.Namespace + "/" + secret
+			}
+			table.SetCell(r+1+i, 0, tview.NewTableCell(secret).SetSelectable(false))
+			table.SetCell(r+1+i, 1, tview.NewTableCell(strings.Join(tls.Hosts, ",")).SetSelectable(false))
+			table.SetCell(r+1+i, 2, tview.NewTableCell(expiry).SetTextColor(color).SetSelectable(false))
+		}
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			onDone(nil)
+			return nil
+		}
+		if event.Key() == tcell.KeyEnter {
+			row, _ := table.GetSelection()
+			if row > 0 && row <= len(routing.Routes) && routing.Routes[row-1].ServiceFound {
+				onDone(&routing.Routes[row-1])
+			}
+			return nil
+		}
+		switch event.Rune() {
+		case 'j':
+			return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+		case 'k':
+			return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+		}
+		return event
+	})
+
+	flex := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(table, 0, 2, true).
+			AddItem(nil, 0, 1, false), 0, 4, true).
+		AddItem(nil, 0, 1, false)
+
+	return flex, table
+}
+
+// routeBackend returns the state of the service behind the route and its color
+func routeBackend(route domain.RouteStatus) (string, tcell.Color) {
+	switch {
+	case !route.ServiceFound:
+		return "service missing: " + route.Error, tcell.ColorRed
+	case route.Error != "":
+		return route.Error, tcell.ColorRed
+	case !route.PortFound:
+		return "port not in service", tcell.ColorRed
+	case route.ReadyEndpoints == 0:
+		return fmt.Sprintf("no ready endpoints (0/%d)", route.Endpoints), tcell.ColorRed
+	case route.ReadyEndpoints < route.Endpoints:
+		return fmt.Sprintf("%d/%d ready", route.ReadyEndpoints, route.Endpoints), tcell.ColorYellow
+	}
+	return fmt.Sprintf("%d/%d ready", route.ReadyEndpoints, route.Endpoints), tcell.ColorGreen
+}
+
+// tlsExpiry returns the expiry of the certificate, yellow in the last 14 days
+func tlsExpiry(tls domain.TLSStatus, now time.Time) (string, tcell.Color) {
+	if tls.Error != "" {
+		return tls.Error, tcell.ColorRed
+	}
+	expiry := tls.NotAfter.UTC().Format(time.RFC3339)
+	left := tls.NotAfter.Sub(now)
+	switch {
+	case left <= 0:
+		return expiry + " (expired)", tcell.ColorRed
+	case left < 14*24*time.Hour:
+		return fmt.Sprintf("%s (in %dd)", expiry, int(left.Hours()/24)), tcell.ColorYellow
+	}
+	return fmt.Sprintf("%s (in %dd)", expiry, int(left.Hours()/24)), tcell.ColorGreen
+}
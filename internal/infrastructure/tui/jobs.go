@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"fmt"
+	"lazykube/internal/domain"
+	"strconv"
+)
+
+// setCronJobSuspend suspends or resumes the schedule of the cronjob and refreshes the table
+func (rD *resourceDict) setCronJobSuspend(namespace, name, kubeContext string, suspend bool) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	err := rD.Controller.CronJob.SetSuspend(ctx, name, namespace, kubeContext, suspend)
+	patch := fmt.Sprintf(`{"spec":{"suspend":%s}}`, strconv.FormatBool(suspend))
+	rD.record(domain.ActionSuspend, kubeContext, namespace, "cronjob/"+name, []string{"patch", "cronjob", name, "-p", patch}, err)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		if rD.Table.query != nil {
+			rD.refresh(*rD.Table.query)
+		}
+	})
+}
+
+// triggerCronJob runs the cronjob now with a job created from its template
+func (rD *resourceDict) triggerCronJob(namespace, name, kubeContext string) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	jobName, err := rD.Controller.CronJob.Trigger(ctx, name, namespace, kubeContext)
+	rD.record(domain.ActionTrigger, kubeContext, namespace, "cronjob/"+name, []string{"create", "job", jobName, "--from=cronjob/" + name}, err)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		rD.View.SetText(fmt.Sprintf("Created job %s from cronjob %s/%s.", jobName, namespace, name))
+	})
+}
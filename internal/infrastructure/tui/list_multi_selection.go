@@ -8,6 +8,8 @@ import (
 type Item struct {
 	Text     string
 	Selected bool
+	// Detail is shown dimmed after the text
+	Detail string
 }
 
 type ListMultiSelection struct {
@@ -40,12 +42,29 @@ func (lMS *ListMultiSelection) AddItem(text string) *ListMultiSelection {
 	return lMS
 }
 
+// AddItemDetail adds an item with a detail, selected or not
+func (lMS *ListMultiSelection) AddItemDetail(text, detail string, selected bool) *ListMultiSelection {
+	lMS.items = append(lMS.items, &Item{
+		Text:     text,
+		Selected: selected,
+		Detail:   detail,
+	})
+	return lMS
+}
+
 func (lMS *ListMultiSelection) FindItems(text string) []int {
-	return []int{0}
+	indexes := []int{}
+	for index, item := range lMS.items {
+		if item.Text == text {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
 }
 
 func (lMS *ListMultiSelection) Clear() {
 	lMS.items = make([]*Item, 0)
+	lMS.currentItem = 0
 }
 
 func (lMS *ListMultiSelection) GetCurrentItem() int {
@@ -167,6 +186,9 @@ func (lMS *ListMultiSelection) Draw(screen tcell.Screen) {
 		}
 
 		line := checkbox + item.Text
+		if item.Detail != "" {
+			line += " [gray]" + item.Detail + "[white]"
+		}
 		if index == lMS.currentItem {
 			line = "[::r]" + line
 		}
@@ -2,11 +2,25 @@ package tui
 
 import (
 	"context"
+	"fmt"
 	"iter"
+	"lazykube/internal/domain"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
 )
 
+// healthInterval is the wait between the health checks of the clusters
+const healthInterval = 30 * time.Second
+
 type menuClusters struct {
 	*ListMultiSelection
+	dict *resourceDict
+	// health is the last check of every context, only used from the ui goroutine
+	health map[string]domain.ClusterHealth
+	// cancelNamespaces stops the load of the namespaces of the previous selection
+	cancelNamespaces context.CancelFunc
 }
 
 func NewMenuClusters(valList iter.Seq[string],
@@ -14,35 +28,115 @@ func NewMenuClusters(valList iter.Seq[string],
 ) *menuClusters {
 	mainList := NewListMultiSelection()
 	for cluster := range valList {
-		mainList.AddItem(cluster)
+		mainList.AddItemDetail(cluster, healthBadge(domain.ClusterChecking), true)
 	}
 	mainList.SetDoneFunc(dict.EventList)
+	mainList.SetBorder(true).SetTitle("Clusters [1]")
+	menu := &menuClusters{
+		ListMultiSelection: mainList,
+		dict:               dict,
+		health:             map[string]domain.ClusterHealth{},
+	}
 	mainList.SetSelectetItemFunc(func(selectedItems []string) {
-		dict.Namespace.Clear()
-		if len(selectedItems) > 1 || len(selectedItems) == 0 {
+		// The refresh in progress is for the old selection
+		dict.CancelRefresh()
+		if menu.cancelNamespaces != nil {
+			menu.cancelNamespaces()
+			menu.cancelNamespaces = nil
+		}
+		if len(selectedItems) == 0 {
+			dict.Namespace.Clear()
 			dict.Namespace.AddItem("default")
 			for _, namespace := range dict.Config.DefaultNamespaces {
 				dict.Namespace.AddItem(namespace)
 			}
-		} else {
-			contextStr := selectedItems[0]
-			namespaces, err := dict.Controller.Namespace.GetAll(context.Background(), contextStr)
-			if err != nil {
-				dict.ErrorModal.SetText(err.Error())
-				dict.Pages.ShowPage("errorModal")
-				return
-			}
-			for _, namespace := range namespaces {
-				dict.Namespace.AddItem(namespace)
-			}
-			intList := dict.Namespace.FindItems("default")
-			if len(intList) > 0 {
-				dict.Namespace.SetCurrentItem(intList[0])
-			}
+			return
 		}
+
+		ctx, cancel := dict.requestContext()
+		menu.cancelNamespaces = cancel
+		go func() {
+			defer cancel()
+			namespaces, err := dict.Controller.Namespace.GetUnion(ctx, selectedItems)
+			dict.App.QueueUpdateDraw(func() {
+				if ctx.Err() == context.Canceled {
+					return
+				}
+				menu.cancelNamespaces = nil
+				dict.Namespace.SetNamespaces(namespaces, len(selectedItems))
+				if err != nil {
+					dict.ErrorModal.SetText(err.Error())
+					dict.Pages.ShowPage("errorModal")
+				}
+			})
+		}()
 	})
-	mainList.SetBorder(true).SetTitle("Clusters [1]")
-	return &menuClusters{
-		mainList,
+	mainList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Rune() == 'h' {
+			menu.showHealth()
+			return nil
+		}
+		return event
+	})
+	return menu
+}
+
+// StartHealthChecks checks the clusters in background and keeps their badges fresh
+func (mC *menuClusters) StartHealthChecks() {
+	go func() {
+		for {
+			ctx, cancel := mC.dict.requestContext()
+			results := mC.dict.Controller.Health.CheckAll(ctx)
+			cancel()
+			mC.dict.App.QueueUpdateDraw(func() {
+				for _, health := range results {
+					mC.health[health.Context] = health
+					for _, index := range mC.FindItems(health.Context) {
+						mC.items[index].Detail = healthBadge(health.Status)
+					}
+				}
+			})
+			time.Sleep(healthInterval)
+		}
+	}()
+}
+
+// showHealth shows the detail of the last health check of the current cluster
+func (mC *menuClusters) showHealth() {
+	if len(mC.items) == 0 {
+		return
+	}
+	contextStr := mC.items[mC.currentItem].Text
+	health, ok := mC.health[contextStr]
+	text := fmt.Sprintf("%s\n\nThe health check is running.", contextStr)
+	if ok {
+		text = fmt.Sprintf("%s\n\nStatus: %s\nVersion: %s\nLatency: %s\nChecked: %s",
+			contextStr, health.Status, health.Version, health.Latency.Round(time.Millisecond), health.CheckedAt.Format(time.TimeOnly))
+		if health.Error != "" {
+			text += "\n\n" + health.Error
+		}
+	}
+
+	modal := tview.NewModal().
+		SetText(text).
+		AddButtons([]string{"OK"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			mC.dict.Pages.RemovePage("clusterHealth")
+			mC.dict.SetFocus(mC)
+		})
+	mC.dict.Pages.AddPage("clusterHealth", modal, true, true)
+	mC.dict.SetFocus(modal)
+}
+
+// healthBadge returns the badge shown after the name of the cluster
+func healthBadge(status string) string {
+	switch status {
+	case domain.ClusterHealthy:
+		return "[green]●"
+	case domain.ClusterUnauthorized:
+		return "[yellow]● auth"
+	case domain.ClusterUnreachable:
+		return "[red]● down"
 	}
+	return "[gray]○"
 }
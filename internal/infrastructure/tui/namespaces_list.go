@@ -1,6 +1,12 @@
 package tui
 
+import (
+	"lazykube/internal/domain"
+	"strings"
+)
 
+// allNamespacesItem is the item of the list that lists every namespace
+const allNamespacesItem = "(all)"
 
 type namespaceList struct {
 	*ListMultiSelection
@@ -20,3 +26,31 @@ func NewNamespaceList(dict *resourceDict) *namespaceList {
 		mainList,
 	}
 }
+
+// SetNamespaces fills the list with the namespaces of the selected contexts.
+// A namespace missing in some contexts shows the contexts that have it.
+func (nL *namespaceList) SetNamespaces(namespaces []domain.Namespace, contexts int) {
+	nL.Clear()
+	nL.AddItemDetail(allNamespacesItem, "", false)
+	for _, namespace := range namespaces {
+		detail := ""
+		if len(namespace.Contexts) < contexts {
+			detail = "(" + strings.Join(namespace.Contexts, ", ") + ")"
+		}
+		nL.AddItemDetail(namespace.Name, detail, true)
+	}
+	if intList := nL.FindItems("default"); len(intList) > 0 {
+		nL.SetCurrentItem(intList[0])
+	}
+}
+
+// GetSelectedNamespaces returns the selected namespaces, the all item is the empty namespace
+func (nL *namespaceList) GetSelectedNamespaces() []string {
+	namespaces := nL.GetTextSelectedItems()
+	for i, namespace := range namespaces {
+		if namespace == allNamespacesItem {
+			namespaces[i] = domain.AllNamespaces
+		}
+	}
+	return namespaces
+}
@@ -1,36 +1,138 @@
 package tui
 
 import (
+	"fmt"
+	"lazykube/internal/domain"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// portChoice is a port declared by the pod spec with the mapping suggested for it
+type portChoice struct {
+	Label   string
+	Mapping string
+}
+
 // NewPortForwardModal creates a modal window for entering port-forwarding information.
-// It takes a callback function 'onOk' which is called with the port string (e.g., "8080:80")
+// The choices are offered in a drop down and the first one fills the ports field.
+// It takes a callback function 'onOk' which is called with the ports string (e.g., "8080:80 :9090")
 // when the user clicks OK, or with an empty string on cancellation.
-func NewPortForwardModal(onOk func(ports string)) *tview.Grid {
+// With allowReconnect the user can choose to move the session to a new pod when the pod is replaced.
+func NewPortForwardModal(choices []portChoice, allowReconnect bool, onOk func(ports string, reconnect bool)) *tview.Grid {
 	form := tview.NewForm()
 	form.SetBackgroundColor(tcell.ColorGray)
 	form.SetBorder(true)
 	form.SetTitle("Port Forward")
 
-	// Add an input field for the ports
-	form.AddInputField("Ports (local:remote)", "", 40, nil, nil)
+	// Add an input field for the ports, many mappings are separated by spaces or commas
+	initial := ""
+	if len(choices) > 0 {
+		initial = choices[0].Mapping
+	}
+	portsField := tview.NewInputField().SetLabel("Ports (local:remote ...)").SetText(initial).SetFieldWidth(40)
+	height := 7
+	if len(choices) > 0 {
+		labels := make([]string, len(choices))
+		for i, choice := range choices {
+			labels[i] = choice.Label
+		}
+		form.AddDropDown("Declared ports", labels, 0, func(_ string, index int) {
+			mappings := parsePortMappings(portsField.GetText())
+			if index < 0 || slices.Contains(mappings, choices[index].Mapping) {
+				return
+			}
+			portsField.SetText(strings.Join(append(mappings, choices[index].Mapping), " "))
+		})
+		height += 2
+	}
+	form.AddFormItem(portsField)
+	if allowReconnect {
+		form.AddCheckbox("Reconnect when the pod is replaced", true, nil)
+		height += 2
+	}
 
 	// Add buttons
 	form.AddButton("OK", func() {
-		inputField := form.GetFormItem(0).(*tview.InputField)
-		ports := inputField.GetText()
-		onOk(ports)
+		reconnect := false
+		if allowReconnect {
+			reconnect = form.GetFormItem(form.GetFormItemCount() - 1).(*tview.Checkbox).IsChecked()
+		}
+		onOk(portsField.GetText(), reconnect)
 	})
 	form.AddButton("Cancel", func() {
-		onOk("") // Indicate cancellation
+		onOk("", false) // Indicate cancellation
 	})
 
 	grid := tview.NewGrid().
-		SetRows(0, 7, 0).                 // Top padding, fixed height, bottom padding
-		SetColumns(0, 80, 0).              // Left padding, fixed width, right padding
+		SetRows(0, height, 0).                // Top padding, fixed height, bottom padding
+		SetColumns(0, 80, 0).                 // Left padding, fixed width, right padding
 		AddItem(form, 1, 1, 1, 1, 0, 0, true) // Add form to the center cell
 
 	return grid
 }
+
+// podPortChoices returns the container ports declared in the pod spec
+func podPortChoices(pod domain.Pod) []portChoice {
+	choices := []portChoice{}
+	for _, container := range pod.Containers {
+		for _, port := range container.Ports {
+			if port.Protocol != "" && port.Protocol != "TCP" {
+				continue
+			}
+			label := fmt.Sprintf("%d (%s)", port.ContainerPort, container.Name)
+			if port.Name != "" {
+				label = fmt.Sprintf("%d %s (%s)", port.ContainerPort, port.Name, container.Name)
+			}
+			choices = append(choices, portChoice{Label: label, Mapping: suggestMapping(port.ContainerPort)})
+		}
+	}
+	return choices
+}
+
+// suggestMapping uses the same local port when it is free, otherwise the
+// local port is chosen when the session starts
+func suggestMapping(remote int32) string {
+	port := strconv.Itoa(int(remote))
+	listener, err := net.Listen("tcp", net.JoinHostPort("localhost", port))
+	if err != nil {
+		return ":" + port
+	}
+	listener.Close()
+	return port + ":" + port
+}
+
+// parsePortMappings splits the mappings separated by spaces or commas
+func parsePortMappings(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+// validatePortMapping checks that the mapping is "remote", "local:remote" or
+// ":remote", where remote is a port number or the name of a container port.
+// The names are resolved against the pod when the session starts.
+func validatePortMapping(mapping string) error {
+	invalid := fmt.Errorf("invalid port format %q, use local:remote or :remote", mapping)
+	local, remote, found := strings.Cut(mapping, ":")
+	if !found {
+		local, remote = "", mapping
+	}
+	if remote == "" || strings.Contains(remote, ":") {
+		return invalid
+	}
+	if _, err := strconv.ParseUint(remote, 10, 16); err != nil && len(validation.IsValidPortName(remote)) > 0 {
+		return invalid
+	}
+	if local != "" {
+		if _, err := strconv.ParseUint(local, 10, 16); err != nil {
+			return invalid
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"lazykube/internal/domain"
+	"net"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestParsePortMappings(t *testing.T) {
+	mappings := parsePortMappings(" 8080:80, :9090\thttp,,5432:postgres ")
+	if expected := []string{"8080:80", ":9090", "http", "5432:postgres"}; !reflect.DeepEqual(mappings, expected) {
+		t.Errorf("expected %v, got %v", expected, mappings)
+	}
+	if mappings := parsePortMappings(" , "); len(mappings) != 0 {
+		t.Errorf("expected no mappings, got %v", mappings)
+	}
+}
+
+func TestValidatePortMapping(t *testing.T) {
+	tests := []struct {
+		mapping string
+		valid   bool
+	}{
+		{"80", true},
+		{"8080:80", true},
+		{":80", true},
+		{"http", true},
+		{"5432:postgres", true},
+		{":metrics", true},
+		{"8080:", false},
+		{":", false},
+		{"", false},
+		{"http:80", false},
+		{"8080:80:90", false},
+		{"70000:80", false},
+		{"8080:70000", false},
+		{"8080:Not_A_Name", false},
+	}
+	for _, test := range tests {
+		if err := validatePortMapping(test.mapping); (err == nil) != test.valid {
+			t.Errorf("expected valid %v for %q, got %v", test.valid, test.mapping, err)
+		}
+	}
+}
+
+func TestPodPortChoices(t *testing.T) {
+	// A port in use gets a random local port
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	busy := int32(listener.Addr().(*net.TCPAddr).Port)
+
+	pod := domain.Pod{Containers: []domain.Container{
+		{Name: "web", Ports: []domain.ContainerPort{{Name: "http", ContainerPort: busy}, {ContainerPort: 53, Protocol: "UDP"}}},
+		{Name: "sidecar", Ports: []domain.ContainerPort{{ContainerPort: busy, Protocol: "TCP"}}},
+	}}
+	choices := podPortChoices(pod)
+	port := strconv.Itoa(int(busy))
+	expected := []portChoice{
+		{Label: port + " http (web)", Mapping: ":" + port},
+		{Label: port + " (sidecar)", Mapping: ":" + port},
+	}
+	if !reflect.DeepEqual(choices, expected) {
+		t.Errorf("expected %+v, got %+v", expected, choices)
+	}
+}
+
+func TestSuggestMappingFreePort(t *testing.T) {
+	// Find a free port, then release it for the suggestion
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	expected := strconv.Itoa(port) + ":" + strconv.Itoa(port)
+	if mapping := suggestMapping(int32(port)); mapping != expected {
+		t.Errorf("expected %s, got %s", expected, mapping)
+	}
+}
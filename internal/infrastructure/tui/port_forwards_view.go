@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"fmt"
+	"lazykube/internal/domain"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// portForwardsView is the page that lists every port-forward session
+type portForwardsView struct {
+	*tview.Table
+	dict *resourceDict
+	// ticking is set while a goroutine refreshes the page, only used from the ui goroutine
+	ticking bool
+}
+
+var portForwardHeaders = []string{"ID", "CLUSTER", "NAMESPACE", "POD", "PORTS", "LOCAL URLS", "STATUS", "RESTARTS", "CONNECTIONS", "LAST ERROR"}
+
+func NewPortForwardsView(dict *resourceDict) *portForwardsView {
+	table := tview.NewTable()
+	table.SetBorder(true).SetTitle("Port Forwards [7]")
+	table.SetSelectable(true, false)
+	table.SetFixed(1, 0)
+
+	view := &portForwardsView{
+		Table: table,
+		dict:  dict,
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEsc:
+			dict.Pages.HidePage("portForwards")
+			dict.SetFocus(dict.Table)
+			return nil
+		case event.Rune() == 'P':
+			dict.showPortForwardProfiles()
+			return nil
+		case event.Key() == tcell.KeyDelete || event.Rune() == 's':
+			if id, ok := view.selectedID(); ok {
+				if err := dict.Controller.PortForward.Stop(id); err != nil {
+					dict.ErrorModal.SetText(err.Error())
+					dict.Pages.ShowPage("errorModal")
+				}
+			}
+			view.Refresh()
+			return nil
+		case event.Rune() == 'x':
+			if id, ok := view.selectedID(); ok {
+				if err := dict.Controller.PortForward.Remove(id); err != nil {
+					dict.ErrorModal.SetText(err.Error())
+					dict.Pages.ShowPage("errorModal")
+				}
+			}
+			view.Refresh()
+			return nil
+		case event.Rune() == 'X':
+			dict.Controller.PortForward.Prune()
+			view.Refresh()
+			return nil
+		}
+		return event
+	})
+
+	return view
+}
+
+// StartTicking keeps the status of the sessions fresh until the page is hidden
+func (pfv *portForwardsView) StartTicking() {
+	if pfv.ticking {
+		return
+	}
+	pfv.ticking = true
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for range ticker.C {
+			visible := true
+			pfv.dict.App.QueueUpdate(func() {
+				if name, _ := pfv.dict.Pages.GetFrontPage(); name != "portForwards" {
+					pfv.ticking, visible = false, false
+					return
+				}
+				pfv.Refresh()
+				pfv.dict.App.ForceDraw()
+			})
+			if !visible {
+				return
+			}
+		}
+	}()
+}
+
+// selectedID returns the id of the session in the selected row
+func (pfv *portForwardsView) selectedID() (int, bool) {
+	row, _ := pfv.GetSelection()
+	if row < 1 {
+		return 0, false
+	}
+	id, err := strconv.Atoi(pfv.GetCell(row, 0).Text)
+	return id, err == nil
+}
+
+// Refresh fills the table with the current state of the sessions
+func (pfv *portForwardsView) Refresh() {
+	row, _ := pfv.GetSelection()
+	pfv.Clear()
+	for col, header := range portForwardHeaders {
+		pfv.SetCell(0, col, tview.NewTableCell(header).SetSelectable(false))
+	}
+	for i, forward := range pfv.dict.Controller.PortForward.List() {
+		r := i + 1
+		pfv.SetCell(r, 0, tview.NewTableCell(strconv.Itoa(forward.ID)))
+		pfv.SetCell(r, 1, tview.NewTableCell(forward.Target.Context))
+		pfv.SetCell(r, 2, tview.NewTableCell(forward.Target.Namespace))
+		pfv.SetCell(r, 3, tview.NewTableCell(forward.Target.Pod))
+		pfv.SetCell(r, 4, tview.NewTableCell(formatForwardedPorts(forward)))
+		pfv.SetCell(r, 5, tview.NewTableCell(formatLocalURLs(forward)))
+		pfv.SetCell(r, 6, tview.NewTableCell(forward.Status).SetTextColor(portForwardStatusColor(forward.Status)))
+		pfv.SetCell(r, 7, tview.NewTableCell(strconv.Itoa(forward.Restarts)))
+		pfv.SetCell(r, 8, tview.NewTableCell(strconv.Itoa(forward.Connections)))
+		pfv.SetCell(r, 9, tview.NewTableCell(forward.LastError).SetTextColor(tcell.ColorRed))
+	}
+	if row > 0 && row < pfv.GetRowCount() {
+		pfv.Select(row, 0)
+	}
+}
+
+// formatForwardedPorts returns the listening ports, or the requested ones before they listen
+func formatForwardedPorts(forward domain.PortForward) string {
+	if len(forward.Bound) == 0 {
+		return strings.Join(forward.Ports, ",")
+	}
+	ports := []string{}
+	for _, port := range forward.Bound {
+		ports = append(ports, fmt.Sprintf("%d->%d", port.Local, port.Remote))
+	}
+	return strings.Join(ports, ",")
+}
+
+// formatLocalURLs returns the local addresses of the listening ports
+func formatLocalURLs(forward domain.PortForward) string {
+	urls := []string{}
+	for _, port := range forward.Bound {
+		urls = append(urls, fmt.Sprintf("http://localhost:%d", port.Local))
+	}
+	return strings.Join(urls, " ")
+}
+
+func portForwardStatusColor(status string) tcell.Color {
+	switch status {
+	case domain.PortForwardActive:
+		return tcell.ColorGreen
+	case domain.PortForwardStarting, domain.PortForwardReconnecting:
+		return tcell.ColorYellow
+	case domain.PortForwardFailed:
+		return tcell.ColorRed
+	}
+	return tcell.ColorGray
+}
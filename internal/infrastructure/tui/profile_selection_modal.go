@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"fmt"
+	"lazykube/internal/infrastructure/config"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// NewProfileSelectionModal creates a modal for selecting a port-forward profile.
+// 'onSelect' is called with the chosen profile, or with a zero-value profile if cancelled.
+func NewProfileSelectionModal(profiles []config.PortForwardProfile, onSelect func(profile config.PortForwardProfile)) (*tview.Flex, *tview.List) {
+	list := tview.NewList()
+	list.SetSelectedBackgroundColor(tcell.ColorLightSkyBlue)
+	list.SetSelectedTextColor(tcell.ColorBlack)
+	list.SetBackgroundColor(tcell.ColorGray)
+	list.SetBorder(true)
+	list.SetTitle("Start a Port-Forward Profile")
+
+	for _, profile := range profiles {
+		list.AddItem(profile.Name, fmt.Sprintf("Forwards: %d", len(profile.Forwards)), 0, func() {
+			onSelect(profile)
+		})
+	}
+
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			onSelect(config.PortForwardProfile{})
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'j':
+				return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+			case 'k':
+				return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+			}
+		}
+		return event
+	})
+
+	flex := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(list, 0, 1, true).
+			AddItem(nil, 0, 1, false), 0, 1, true).
+		AddItem(nil, 0, 1, false)
+
+	flex.SetBackgroundColor(tcell.ColorGray)
+
+	return flex, list
+}
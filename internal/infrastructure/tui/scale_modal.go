@@ -0,0 +1,34 @@
+package tui
+
+import (
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// NewScaleModal creates a modal window for entering the replicas of a workload.
+// 'onOk' is called with the replicas written by the user, or with an empty string on cancellation.
+func NewScaleModal(replicas int, onOk func(replicas string)) *tview.Grid {
+	form := tview.NewForm()
+	form.SetBackgroundColor(tcell.ColorGray)
+	form.SetBorder(true)
+	form.SetTitle("Scale")
+
+	form.AddInputField("Replicas", strconv.Itoa(replicas), 10, tview.InputFieldInteger, nil)
+
+	form.AddButton("OK", func() {
+		inputField := form.GetFormItem(0).(*tview.InputField)
+		onOk(inputField.GetText())
+	})
+	form.AddButton("Cancel", func() {
+		onOk("")
+	})
+
+	grid := tview.NewGrid().
+		SetRows(0, 7, 0).
+		SetColumns(0, 40, 0).
+		AddItem(form, 1, 1, 1, 1, 0, 0, true)
+
+	return grid
+}
@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"fmt"
+)
+
+// toggleSecretDecode shows the secret in the yaml view with the values decoded,
+// or masked again when they are already decoded
+func (rD *resourceDict) toggleSecretDecode(namespace, name, kubeContext string) {
+	target := yamlTarget{typeR: "Secrets", namespace: namespace, name: name, kubeContext: kubeContext, mode: yamlModeDecoded}
+	if rD.yaml != nil && *rD.yaml == target {
+		target.mode = yamlModeManifest
+	}
+	rD.yaml = &target
+	rD.loadYaml(target, false)
+}
+
+// showCopySecretValue asks for a key of the secret and copies its decoded value
+// to the clipboard of the terminal
+func (rD *resourceDict) showCopySecretValue(namespace, name, kubeContext string) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	secret, err := rD.Controller.Secret.GetSecret(ctx, name, namespace, kubeContext)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		if len(secret.Keys) == 0 {
+			rD.ErrorModal.SetText(fmt.Sprintf("Secret %s has no values.", name))
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		keys := make([]string, len(secret.Keys))
+		for i, key := range secret.Keys {
+			keys[i] = key.Name
+		}
+		modal, list := NewContainerSelectionModal(keys, func(key string) {
+			rD.Pages.RemovePage("secretKeySelection")
+			rD.SetFocus(rD.Table)
+			if key != "" {
+				go rD.copySecretValue(namespace, name, kubeContext, key)
+			}
+		})
+		list.SetBorder(true).SetTitle("Copy value of")
+		rD.Pages.AddPage("secretKeySelection", modal, true, true)
+		rD.SetFocus(list)
+	})
+}
+
+func (rD *resourceDict) copySecretValue(namespace, name, kubeContext, key string) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	value, err := rD.Controller.Secret.GetValue(ctx, name, namespace, kubeContext, key)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		rD.Screen.SetClipboard(value)
+		rD.View.SetText(fmt.Sprintf("Copied %s of secret %s/%s to the clipboard.", key, namespace, name))
+	})
+}
@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"fmt"
+	"lazykube/internal/domain"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+var endpointHeaders = []string{"ADDRESSES", "PORTS", "STATE", "POD", "NODE", "ZONE", "SLICE"}
+
+// showServiceEndpoints lists the backends of the service, Enter or 'l' on one
+// shows the logs of its pod
+func (rD *resourceDict) showServiceEndpoints(namespace, name, kubeContext string) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	endpoints, err := rD.Controller.Service.GetEndpoints(ctx, name, namespace, kubeContext)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		modal, table := NewEndpointsModal(fmt.Sprintf("Endpoints of %s/%s", namespace, name), endpoints, func(endpoint *domain.ServiceEndpoint) {
+			rD.Pages.RemovePage("endpoints")
+			rD.SetFocus(rD.Table)
+			if endpoint != nil {
+				go rD.showLogsForPod(domain.Pod{Name: endpoint.Pod, Namespace: namespace, Context: kubeContext}, "")
+			}
+		})
+		rD.Pages.AddPage("endpoints", modal, true, true)
+		rD.SetFocus(table)
+	})
+}
+
+// NewEndpointsModal creates a modal listing the endpoints of a service.
+// 'onDone' is called with the endpoint chosen for its logs, or with nil when closed.
+func NewEndpointsModal(title string, endpoints []domain.ServiceEndpoint, onDone func(endpoint *domain.ServiceEndpoint)) (*tview.Flex, *tview.Table) {
+	table := tview.NewTable()
+	table.SetSelectable(true, false)
+	table.SetFixed(1, 0)
+	table.SetBorder(true)
+	table.SetTitle(title + " (Enter/l: logs, Esc: close)")
+
+	for col, header := range endpointHeaders {
+		table.SetCell(0, col, tview.NewTableCell(header).SetSelectable(false))
+	}
+	if len(endpoints) == 0 {
+		table.SetCell(1, 0, tview.NewTableCell("No endpoints, check the selector of the service and the readiness of its pods").
+			SetTextColor(tcell.ColorRed).SetSelectable(false))
+	}
+	for i, endpoint := range endpoints {
+		r := i + 1
+		state, color := endpointState(endpoint)
+		table.SetCell(r, 0, tview.NewTableCell(strings.Join(endpoint.Addresses, ",")))
+		table.SetCell(r, 1, tview.NewTableCell(strings.Join(endpoint.Ports, ",")))
+		table.SetCell(r, 2, tview.NewTableCell(state).SetTextColor(color))
+		table.SetCell(r, 3, tview.NewTableCell(endpoint.Pod))
+		table.SetCell(r, 4, tview.NewTableCell(endpoint.Node))
+		table.SetCell(r, 5, tview.NewTableCell(endpoint.Zone))
+		table.SetCell(r, 6, tview.NewTableCell(endpoint.Slice))
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			onDone(nil)
+			return nil
+		}
+		if event.Key() == tcell.KeyEnter || event.Rune() == 'l' {
+			row, _ := table.GetSelection()
+			if row > 0 && row <= len(endpoints) && endpoints[row-1].Pod != "" {
+				onDone(&endpoints[row-1])
+			}
+			return nil
+		}
+		switch event.Rune() {
+		case 'j':
+			return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+		case 'k':
+			return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+		}
+		return event
+	})
+
+	flex := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(table, 0, 2, true).
+			AddItem(nil, 0, 1, false), 0, 4, true).
+		AddItem(nil, 0, 1, false)
+
+	return flex, table
+}
+
+// endpointState returns the state of the endpoint and its color
+func endpointState(endpoint domain.ServiceEndpoint) (string, tcell.Color) {
+	switch {
+	case endpoint.Terminating && endpoint.Serving:
+		return "terminating (serving)", tcell.ColorYellow
+	case endpoint.Terminating:
+		return "terminating", tcell.ColorYellow
+	case endpoint.Ready:
+		return "ready", tcell.ColorGreen
+	}
+	return "not ready", tcell.ColorRed
+}
@@ -0,0 +1,91 @@
+//go:build !windows
+
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"golang.org/x/term"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// runSuspended stops drawing the tui and gives the real terminal to the session.
+// The session gets its own handle of the tty, so closing it when the session ends
+// releases the reader goroutine before the tui reads the keyboard again.
+func (rD *resourceDict) runSuspended(session func(options remotecommand.StreamOptions) error) error {
+	var err error
+	suspended := rD.App.Suspend(func() {
+		tty, openErr := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+		if openErr != nil {
+			err = fmt.Errorf("failed to open the terminal: %w", openErr)
+			return
+		}
+		defer tty.Close()
+
+		fd := int(tty.Fd())
+		state, rawErr := term.MakeRaw(fd)
+		if rawErr != nil {
+			err = fmt.Errorf("failed to set the terminal in raw mode: %w", rawErr)
+			return
+		}
+		defer term.Restore(fd, state)
+
+		sizes := newTerminalSizeQueue(fd)
+		defer sizes.stop()
+
+		err = session(remotecommand.StreamOptions{
+			Stdin:             tty,
+			Stdout:            tty,
+			Tty:               true,
+			TerminalSizeQueue: sizes,
+		})
+	})
+	if !suspended {
+		return fmt.Errorf("failed to suspend the application")
+	}
+	return err
+}
+
+// terminalSizeQueue forwards the size of the local terminal every time it changes
+type terminalSizeQueue struct {
+	fd      int
+	resize  chan os.Signal
+	done    chan struct{}
+	pending bool
+}
+
+func newTerminalSizeQueue(fd int) *terminalSizeQueue {
+	q := &terminalSizeQueue{
+		fd:      fd,
+		resize:  make(chan os.Signal, 1),
+		done:    make(chan struct{}),
+		pending: true,
+	}
+	signal.Notify(q.resize, syscall.SIGWINCH)
+	return q
+}
+
+// Next blocks until the terminal is resized, the first call return the current size
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	if !q.pending {
+		select {
+		case <-q.resize:
+		case <-q.done:
+			return nil
+		}
+	}
+	q.pending = false
+	width, height, err := term.GetSize(q.fd)
+	if err != nil {
+		return nil
+	}
+	return &remotecommand.TerminalSize{Width: uint16(width), Height: uint16(height)}
+}
+
+func (q *terminalSizeQueue) stop() {
+	signal.Stop(q.resize)
+	close(q.done)
+}
@@ -0,0 +1,12 @@
+package tui
+
+import (
+	"errors"
+
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// runSuspended is not available on windows, the embedded terminal must be used
+func (rD *resourceDict) runSuspended(session func(options remotecommand.StreamOptions) error) error {
+	return errors.New("exec in the suspended terminal is not supported on windows")
+}
@@ -1,51 +1,97 @@
 package tui
 
 import (
-	"context"
+	"fmt"
 	"lazykube/internal/domain"
+	"strconv"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
+// tableTitle is the title of the table without the state of the refresh
+const tableTitle = "Table Resources [5]"
+
+// resourceColumn is a column of the table after the name, namespace and cluster
+type resourceColumn struct {
+	Header string
+	// Key is the field of the results of the controller
+	Key string
+}
+
+// resourceColumns are the columns shown for every type
+var resourceColumns = map[string][]resourceColumn{
+	"Pods":                     {{Header: "STATUS", Key: "status"}},
+	"Deployments":              {{Header: "READY", Key: "replicas"}},
+	"Services":                 {{Header: "TYPE", Key: "type"}, {Header: "CLUSTER-IP", Key: "cluster_ip"}, {Header: "EXTERNAL-IP", Key: "external_ips"}, {Header: "PORTS", Key: "ports"}},
+	"Secrets":                  {{Header: "TYPE", Key: "type"}, {Header: "KEYS", Key: "keys"}},
+	"ConfigMaps":               {{Header: "KEYS", Key: "keys"}},
+	"Jobs":                     {{Header: "COMPLETIONS", Key: "completions"}, {Header: "DURATION", Key: "duration"}, {Header: "STATUS", Key: "status"}, {Header: "CRONJOB", Key: "cron_job"}},
+	"StatefulSets":             {{Header: "READY", Key: "ready"}, {Header: "CURRENT", Key: "current"}, {Header: "UPDATED", Key: "updated"}, {Header: "SERVICE", Key: "service"}},
+	"DaemonSets":               {{Header: "DESIRED", Key: "desired"}, {Header: "CURRENT", Key: "current"}, {Header: "READY", Key: "ready"}, {Header: "UP-TO-DATE", Key: "up_to_date"}, {Header: "AVAILABLE", Key: "available"}},
+	"Ingresses":                {{Header: "CLASS", Key: "class"}, {Header: "HOSTS", Key: "hosts"}, {Header: "ADDRESS", Key: "address"}, {Header: "TLS", Key: "tls"}},
+	"HTTPRoutes":               {{Header: "GATEWAYS", Key: "gateways"}, {Header: "HOSTS", Key: "hosts"}, {Header: "ROUTES", Key: "routes"}},
+	"PersistentVolumeClaims":   {{Header: "STATUS", Key: "status"}, {Header: "VOLUME", Key: "volume"}, {Header: "CAPACITY", Key: "capacity"}, {Header: "ACCESS MODES", Key: "access_modes"}, {Header: "STORAGECLASS", Key: "storage_class"}, {Header: "MOUNTED BY", Key: "mounted_by"}, {Header: "REASON", Key: "reason"}},
+	"PersistentVolumes":        {{Header: "STATUS", Key: "status"}, {Header: "CLAIM", Key: "claim"}, {Header: "CAPACITY", Key: "capacity"}, {Header: "ACCESS MODES", Key: "access_modes"}, {Header: "RECLAIM", Key: "reclaim_policy"}, {Header: "STORAGECLASS", Key: "storage_class"}, {Header: "REASON", Key: "reason"}},
+	"HorizontalPodAutoscalers": {{Header: "REFERENCE", Key: "reference"}, {Header: "TARGETS", Key: "targets"}, {Header: "MIN", Key: "min"}, {Header: "MAX", Key: "max"}, {Header: "REPLICAS", Key: "replicas"}, {Header: "CONDITIONS", Key: "conditions"}},
+	"CronJobs":                 {{Header: "SCHEDULE", Key: "schedule"}, {Header: "SUSPEND", Key: "suspend"}, {Header: "ACTIVE", Key: "active"}, {Header: "LAST RUN", Key: "last_run"}, {Header: "NEXT RUN", Key: "next_run"}},
+}
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
 type tableResource struct {
 	*tview.Table
 	ResourceType string
+	// Errors are the rows of the contexts and namespaces that failed
+	Errors map[int]domain.ContextError
+	// loading counts the refreshes in progress, only used from the ui goroutine
+	loading int
+	frame   int
+	// query is the last query shown, repeated by the auto-refresh unless paused
+	query       *resourceQuery
+	lastUpdated time.Time
+	paused      bool
 }
 
 func NewTableResource(dict *resourceDict) *tableResource {
 	table := tview.NewTable()
-	table.SetBorder(true).SetTitle("Table Resources [5]")
+	table.SetBorder(true).SetTitle(tableTitle)
 	table.SetSelectable(true, false)
 	table.SetFixed(1, 0)
+	table.SetSelectionChangedFunc(func(row, column int) {
+		dict.updateResourceKeys()
+	})
 	table.SetSelectedFunc(func(row int, col int) {
-		name := table.GetCell(row, col).Text
-		namespace := table.GetCell(row, 1).Text
-		kubeContext := table.GetCell(row, 2).Text
-		typeR := dict.Table.ResourceType
-
-		results := []byte{}
-		var err error
-		switch typeR {
-		case "Deployments":
-			results, err = dict.Controller.Deployment.GetYaml(context.Background(), namespace, name, kubeContext)
-		case "Pods":
-			results, err = dict.Controller.Pod.GetYaml(context.Background(), namespace, name, kubeContext)
-		}
-		dict.View.Clear()
-		if err != nil {
-			dict.ErrorModal.SetText(err.Error())
+		if contextErr, ok := dict.Table.Errors[row]; ok {
+			dict.ErrorModal.SetText(contextErr.Error())
 			dict.Pages.ShowPage("errorModal")
-		} else {
-			dict.View.SetText(string(results))
-			dict.App.SetFocus(dict.View)
+			return
 		}
+		if dict.deniedKey("Enter") {
+			return
+		}
+		name := table.GetCell(row, 0).Text
+		namespace := table.GetCell(row, 1).Text
+		kubeContext := table.GetCell(row, 2).Text
+		dict.showYaml(dict.Table.ResourceType, namespace, name, kubeContext)
 	})
 	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Rune() == 'a' {
+			dict.Table.paused = !dict.Table.paused
+			dict.Table.updateTitle()
+			return nil
+		}
 		row, _ := table.GetSelection()
 		if row < 1 { // No item selected
 			return event
 		}
+		if _, ok := dict.Table.Errors[row]; ok { // Only Enter shows the details of an error
+			return event
+		}
+		if event.Key() == tcell.KeyRune && dict.deniedKey(string(event.Rune())) {
+			return nil
+		}
 		name := table.GetCell(row, 0).Text
 		namespace := table.GetCell(row, 1).Text
 		kubeContext := table.GetCell(row, 2).Text
@@ -57,49 +103,156 @@ func NewTableResource(dict *resourceDict) *tableResource {
 			Context:   kubeContext,
 		}
 
+		// The workloads run their actions in a pod chosen by the user, the jobs only show logs
+		workload := typeR == "Pods" || (dict.podOwner(typeR) != nil && typeR != "Jobs")
+
 		switch event.Rune() {
 		case 'l':
+			if !workload && typeR != "Jobs" {
+				break
+			}
 			go func() {
 				if typeR == "Pods" {
 					dict.showLogsForPod(pod, "")
-				} else if typeR == "Deployments" {
-					dict.showLogsForDeployment(name, namespace, kubeContext)
+				} else {
+					dict.showLogsForWorkload(typeR, name, namespace, kubeContext)
 				}
 			}()
 		case 'e':
-			go func() {
-				if typeR == "Pods" {
-					dict.showExecForPod(pod, "")
-				} else if typeR == "Deployments" {
-					dict.showExecForDeployment(name, namespace, kubeContext)
-				}
-			}()
+			if !workload {
+				break
+			}
+			dict.guard(domain.ActionExec, kubeContext, name, func() {
+				go func() {
+					if typeR == "Pods" {
+						dict.showExecForPod(pod, "", nil)
+					} else {
+						dict.showExecForWorkload(typeR, name, namespace, kubeContext, nil)
+					}
+				}()
+			})
+		case 'E':
+			if !workload {
+				break
+			}
+			dict.guard(domain.ActionExec, kubeContext, name, func() {
+				go dict.showExecCommandPrompt(func(command []string) {
+					if typeR == "Pods" {
+						dict.showExecForPod(pod, "", command)
+					} else {
+						dict.showExecForWorkload(typeR, name, namespace, kubeContext, command)
+					}
+				})
+			})
+		case 'c':
+			if !workload {
+				break
+			}
+			dict.guard(domain.ActionCopy, kubeContext, name, func() {
+				go func() {
+					if typeR == "Pods" {
+						dict.showCopyForPod(pod)
+					} else {
+						dict.showCopyForWorkload(typeR, name, namespace, kubeContext)
+					}
+				}()
+			})
+		case 'D':
+			if !workload {
+				break
+			}
+			dict.guard(domain.ActionDebug, kubeContext, name, func() {
+				go func() {
+					if typeR == "Pods" {
+						dict.showDebugForPod(pod)
+					} else {
+						dict.showDebugForWorkload(typeR, name, namespace, kubeContext)
+					}
+				}()
+			})
 		case 'y':
-			results := []byte{}
-			var err error
-			switch typeR {
-			case "Deployments":
-				results, err = dict.Controller.Deployment.GetYaml(context.Background(), namespace, name, kubeContext)
-			case "Pods":
-				results, err = dict.Controller.Pod.GetYaml(context.Background(), namespace, name, kubeContext)
-			}
-			dict.View.Clear()
-			if err != nil {
-				dict.ErrorModal.SetText(err.Error())
-				dict.Pages.ShowPage("errorModal")
-			} else {
-				dict.View.SetText(string(results))
-				dict.App.SetFocus(dict.View)
+			dict.showYaml(typeR, namespace, name, kubeContext)
+		case 'x':
+			if typeR == "Secrets" {
+				dict.toggleSecretDecode(namespace, name, kubeContext)
+			}
+		case 'Y':
+			if typeR == "Secrets" {
+				go dict.showCopySecretValue(namespace, name, kubeContext)
+			}
+		case 'v':
+			if typeR == "ConfigMaps" {
+				go dict.selectConfigMapKey(namespace, name, kubeContext, "Preview key", func(key domain.ConfigMapKey) {
+					dict.showConfigMapKey(namespace, name, kubeContext, key.Name)
+				})
+			}
+		case 'V':
+			if typeR == "ConfigMaps" {
+				dict.guard(domain.ActionEdit, kubeContext, name, func() {
+					go dict.selectConfigMapKey(namespace, name, kubeContext, "Edit key", func(key domain.ConfigMapKey) {
+						go dict.editConfigMapKey(namespace, name, kubeContext, key)
+					})
+				})
+			}
+		case 'u':
+			if typeR == "ConfigMaps" {
+				dict.showConfigMapReferences(namespace, name, kubeContext)
+			}
+		case 's':
+			if typeR == "CronJobs" {
+				suspend := dict.Table.columnText(row, "suspend") != "true"
+				dict.guard(domain.ActionSuspend, kubeContext, name, func() {
+					go dict.setCronJobSuspend(namespace, name, kubeContext, suspend)
+				})
+			}
+		case 't':
+			if typeR == "CronJobs" {
+				dict.guard(domain.ActionTrigger, kubeContext, name, func() {
+					go dict.triggerCronJob(namespace, name, kubeContext)
+				})
+			}
+		case 'b':
+			if typeR == "Services" {
+				go dict.showServiceEndpoints(namespace, name, kubeContext)
+			} else if typeR == "Ingresses" || typeR == "HTTPRoutes" {
+				go dict.showIngressRouting(typeR, namespace, name, kubeContext)
+			}
+		case 'R':
+			if typeR == "StatefulSets" || typeR == "DaemonSets" {
+				dict.guard(domain.ActionRestart, kubeContext, name, func() {
+					go dict.restartWorkload(typeR, namespace, name, kubeContext)
+				})
+			}
+		case 'S':
+			if typeR == "StatefulSets" {
+				replicas := desiredReplicas(dict.Table.columnText(row, "ready"))
+				dict.guard(domain.ActionScale, kubeContext, name, func() {
+					dict.showScaleStatefulSet(namespace, name, kubeContext, replicas)
+				})
+			} else if typeR == "HorizontalPodAutoscalers" {
+				minReplicas, _ := strconv.Atoi(dict.Table.columnText(row, "min"))
+				maxReplicas, _ := strconv.Atoi(dict.Table.columnText(row, "max"))
+				dict.guard(domain.ActionEdit, kubeContext, name, func() {
+					dict.showEditAutoscaler(namespace, name, kubeContext, minReplicas, maxReplicas)
+				})
+			}
+		case 'h':
+			if _, ok := autoscalerKinds[typeR]; ok || typeR == "HorizontalPodAutoscalers" {
+				dict.showAutoscaler(typeR, namespace, name, kubeContext)
 			}
 		case 'p':
-			go func() {
-				switch typeR {
-				case "Pods":
-					dict.showPortForwardForPod(pod)
-				case "Deployments":
-					dict.showPortForwardForDeployment(name, namespace, kubeContext)
-				}
-			}()
+			dict.guard(domain.ActionPortForward, kubeContext, name, func() {
+				go func() {
+					switch typeR {
+					case "Pods":
+						dict.showPortForwardForPod(pod, "")
+					case "Deployments", "StatefulSets", "DaemonSets":
+						dict.showPortForwardForWorkload(typeR, name, namespace, kubeContext)
+					case "Services":
+						dict.showPortForwardForService(name, namespace, kubeContext)
+					}
+				}()
+			})
 		}
 		return event
 	})
@@ -107,3 +260,85 @@ func NewTableResource(dict *resourceDict) *tableResource {
 		Table: table,
 	}
 }
+
+// columnText returns the text of the row in the column with the key of the results
+func (tR *tableResource) columnText(row int, key string) string {
+	for i, column := range resourceColumns[tR.ResourceType] {
+		if column.Key == key {
+			return tR.GetCell(row, 3+i).Text
+		}
+	}
+	return ""
+}
+
+// setErrorRow marks a context and namespace that failed, Enter on it shows the error
+func (tR *tableResource) setErrorRow(row int, contextErr domain.ContextError) {
+	namespace := contextErr.Namespace
+	if namespace == domain.AllNamespaces {
+		namespace = allNamespacesItem
+	}
+	tR.Errors[row] = contextErr
+	tR.SetCell(row, 0, tview.NewTableCell("! error, Enter for details").SetTextColor(tcell.ColorRed))
+	tR.SetCell(row, 1, tview.NewTableCell(namespace).SetTextColor(tcell.ColorRed))
+	tR.SetCell(row, 2, tview.NewTableCell(contextErr.Context).SetTextColor(tcell.ColorRed))
+}
+
+// StartLoading shows a spinner in the title until the returned function is
+// called, both must be called from the ui goroutine
+func (tR *tableResource) StartLoading(app *tview.Application) func() {
+	tR.loading++
+	tR.updateTitle()
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				app.QueueUpdateDraw(func() {
+					tR.frame = (tR.frame + 1) % len(spinnerFrames)
+					tR.updateTitle()
+				})
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		tR.loading--
+		tR.updateTitle()
+	}
+}
+
+// StartAutoRefresh keeps the freshness of the title and refreshes the table
+// when the interval of its type passes. The screen is only drawn when the
+// title changes and no page covers the table.
+func (tR *tableResource) StartAutoRefresh(dict *resourceDict) {
+	go func() {
+		for range time.Tick(time.Second) {
+			dict.App.QueueUpdate(func() {
+				title := tR.GetTitle()
+				dict.autoRefresh()
+				tR.updateTitle()
+				if name, _ := dict.Pages.GetFrontPage(); title != tR.GetTitle() && name != "portForwards" && name != "audit" {
+					dict.App.ForceDraw()
+				}
+			})
+		}
+	}()
+}
+
+func (tR *tableResource) updateTitle() {
+	title := tableTitle
+	if !tR.lastUpdated.IsZero() {
+		title += fmt.Sprintf(" updated %ds ago", int(time.Since(tR.lastUpdated).Seconds()))
+		if tR.paused {
+			title += " (paused)"
+		}
+	}
+	if tR.loading > 0 {
+		title += " " + spinnerFrames[tR.frame] + " loading"
+	}
+	tR.SetTitle(title)
+}
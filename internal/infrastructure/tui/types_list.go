@@ -13,6 +13,18 @@ func NewTypeList(dict *resourceDict) *typeList {
 	mainList := tview.NewList().ShowSecondaryText(false)
 	mainList.AddItem("Deployments", "", rune(0), nil)
 	mainList.AddItem("Pods", "", rune(0), nil)
+	mainList.AddItem("StatefulSets", "", rune(0), nil)
+	mainList.AddItem("DaemonSets", "", rune(0), nil)
+	mainList.AddItem("HorizontalPodAutoscalers", "", rune(0), nil)
+	mainList.AddItem("Services", "", rune(0), nil)
+	mainList.AddItem("Ingresses", "", rune(0), nil)
+	mainList.AddItem("HTTPRoutes", "", rune(0), nil)
+	mainList.AddItem("Secrets", "", rune(0), nil)
+	mainList.AddItem("ConfigMaps", "", rune(0), nil)
+	mainList.AddItem("Jobs", "", rune(0), nil)
+	mainList.AddItem("CronJobs", "", rune(0), nil)
+	mainList.AddItem("PersistentVolumeClaims", "", rune(0), nil)
+	mainList.AddItem("PersistentVolumes", "", rune(0), nil)
 
 	mainList.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if event.Rune() == 'k' {
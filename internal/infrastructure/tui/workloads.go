@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"fmt"
+	"lazykube/internal/domain"
+	"strconv"
+	"strings"
+)
+
+// restartWorkload restarts the pods of the statefulset or daemonset and refreshes the table
+func (rD *resourceDict) restartWorkload(typeR, namespace, name, kubeContext string) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	var err error
+	kind := "statefulset"
+	switch typeR {
+	case "StatefulSets":
+		err = rD.Controller.StatefulSet.Restart(ctx, name, namespace, kubeContext)
+	case "DaemonSets":
+		kind = "daemonset"
+		err = rD.Controller.DaemonSet.Restart(ctx, name, namespace, kubeContext)
+	default:
+		return
+	}
+	object := kind + "/" + name
+	rD.record(domain.ActionRestart, kubeContext, namespace, object, []string{"rollout", "restart", object}, err)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		if rD.Table.query != nil {
+			rD.refresh(*rD.Table.query)
+		}
+	})
+}
+
+// showScaleStatefulSet asks for the replicas of the statefulset and scales it
+func (rD *resourceDict) showScaleStatefulSet(namespace, name, kubeContext string, replicas int) {
+	modal := NewScaleModal(replicas, func(text string) {
+		rD.Pages.RemovePage("scale")
+		rD.SetFocus(rD.Table)
+		if strings.TrimSpace(text) == "" {
+			return
+		}
+		replicas, err := strconv.ParseInt(text, 10, 32)
+		if err != nil {
+			rD.ErrorModal.SetText(fmt.Sprintf("invalid replicas: %s", text))
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		go rD.scaleStatefulSet(namespace, name, kubeContext, int32(replicas))
+	})
+	rD.Pages.AddPage("scale", modal, true, true)
+	rD.SetFocus(modal)
+}
+
+// scaleStatefulSet sets the replicas of the statefulset and refreshes the table
+func (rD *resourceDict) scaleStatefulSet(namespace, name, kubeContext string, replicas int32) {
+	ctx, cancel := rD.requestContext()
+	defer cancel()
+	err := rD.Controller.StatefulSet.Scale(ctx, name, namespace, kubeContext, replicas)
+	object := "statefulset/" + name
+	rD.record(domain.ActionScale, kubeContext, namespace, object, []string{"scale", object, fmt.Sprintf("--replicas=%d", replicas)}, err)
+	rD.App.QueueUpdateDraw(func() {
+		if err != nil {
+			rD.ErrorModal.SetText(err.Error())
+			rD.Pages.ShowPage("errorModal")
+			return
+		}
+		if rD.Table.query != nil {
+			rD.refresh(*rD.Table.query)
+		}
+	})
+}
+
+// desiredReplicas returns the replicas after the slash of a "ready/desired" column
+func desiredReplicas(ready string) int {
+	_, desired, _ := strings.Cut(ready, "/")
+	replicas, err := strconv.Atoi(desired)
+	if err != nil {
+		return 1
+	}
+	return replicas
+}
@@ -0,0 +1,19 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewAccessController() controller.AccessController {
+	accessGates := map[string]interGate.AccessGateway{}
+	for key, client := range r.clients {
+		accessGates[key] = k8s.NewAccessGateway(client, key)
+	}
+
+	return controller.NewAccessController(
+		usecase.NewAccessInteractor(accessGates),
+	)
+}
@@ -0,0 +1,13 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/datastore"
+	"lazykube/internal/usecase"
+)
+
+func (r *registry) NewAuditController() controller.AuditController {
+	return controller.NewAuditController(
+		usecase.NewAuditInteractor(datastore.NewAuditJournal(r.auditFile)),
+	)
+}
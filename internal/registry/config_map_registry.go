@@ -0,0 +1,19 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewConfigMapController() controller.ConfigMapController {
+	configMapGates := map[string]interGate.ConfigMapResourceGateway{}
+	for key, client := range r.clients {
+		configMapGates[key] = k8s.NewConfigMapGateway(client, key)
+	}
+
+	return controller.NewConfigMapController(
+		usecase.NewConfigMapInteractor(configMapGates),
+	)
+}
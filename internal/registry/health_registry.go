@@ -0,0 +1,34 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+	"time"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+// healthTimeout bounds the health check of a dead cluster
+const healthTimeout = 5 * time.Second
+
+func (r *registry) NewHealthController() controller.HealthController {
+	healthGates := map[string]interGate.HealthGateway{}
+	for key, client := range r.clients {
+		var versioner discovery.ServerVersionInterface = client.Discovery()
+		if config := r.configs[key]; config != nil {
+			config = rest.CopyConfig(config)
+			config.Timeout = healthTimeout
+			if discoveryClient, err := discovery.NewDiscoveryClientForConfig(config); err == nil {
+				versioner = discoveryClient
+			}
+		}
+		healthGates[key] = k8s.NewHealthGateway(versioner, key)
+	}
+
+	return controller.NewHealthController(
+		usecase.NewHealthInteractor(healthGates),
+	)
+}
@@ -0,0 +1,19 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewHorizontalPodAutoscalerController() controller.AutoscalerController {
+	autoscalerGates := map[string]interGate.HorizontalPodAutoscalerResourceGateway{}
+	for key, client := range r.clients {
+		autoscalerGates[key] = k8s.NewHorizontalPodAutoscalerGateway(client, key)
+	}
+
+	return controller.NewHorizontalPodAutoscalerController(
+		usecase.NewHorizontalPodAutoscalerInteractor(autoscalerGates),
+	)
+}
@@ -0,0 +1,47 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+	"log/slog"
+
+	"k8s.io/client-go/dynamic"
+)
+
+func (r *registry) NewIngressController() controller.IngressController {
+	ingressGates := map[string]interGate.IngressResourceGateway{}
+	for key, client := range r.clients {
+		ingressGates[key] = k8s.NewIngressGateway(client, key)
+	}
+	return r.newRoutingController(ingressGates)
+}
+
+// NewHTTPRouteController reads the httproutes with a dynamic client, the contexts
+// whose client can't be created have no gateway
+func (r *registry) NewHTTPRouteController() controller.IngressController {
+	routeGates := map[string]interGate.IngressResourceGateway{}
+	for key, config := range r.configs {
+		client, err := dynamic.NewForConfig(config)
+		if err != nil {
+			slog.Warn("creating the dynamic client", "context", key, "error", err)
+			continue
+		}
+		routeGates[key] = k8s.NewHTTPRouteGateway(client, key)
+	}
+	return r.newRoutingController(routeGates)
+}
+
+func (r *registry) newRoutingController(gates map[string]interGate.IngressResourceGateway) controller.IngressController {
+	serviceGates := map[string]interGate.ServiceResourceGateway{}
+	secretGates := map[string]interGate.SecretResourceGateway{}
+	for key, client := range r.clients {
+		serviceGates[key] = k8s.NewServiceGateway(client, key)
+		secretGates[key] = k8s.NewSecretGateway(client, key)
+	}
+
+	return controller.NewIngressController(
+		usecase.NewIngressInteractor(gates, serviceGates, secretGates),
+	)
+}
@@ -0,0 +1,30 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewJobController() controller.JobController {
+	jobGates := map[string]interGate.JobResourceGateway{}
+	for key, client := range r.clients {
+		jobGates[key] = k8s.NewJobGateway(client, key)
+	}
+
+	return controller.NewJobController(
+		usecase.NewJobInteractor(jobGates),
+	)
+}
+
+func (r *registry) NewCronJobController() controller.CronJobController {
+	cronJobGates := map[string]interGate.CronJobResourceGateway{}
+	for key, client := range r.clients {
+		cronJobGates[key] = k8s.NewCronJobGateway(client, key)
+	}
+
+	return controller.NewCronJobController(
+		usecase.NewCronJobInteractor(cronJobGates),
+	)
+}
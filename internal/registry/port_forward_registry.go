@@ -0,0 +1,24 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewPortForwardController() controller.PortForwardController {
+	podGates := map[string]interGate.PodResourceGateway{}
+	deployGates := map[string]interGate.DeploymentResourceGateway{}
+	serviceGates := map[string]interGate.ServiceResourceGateway{}
+	for key, client := range r.clients {
+		config := r.configs[key]
+		podGates[key] = k8s.NewPodGateway(client, config, key)
+		deployGates[key] = k8s.NewDeploymentGateway(client, config, key)
+		serviceGates[key] = k8s.NewServiceGateway(client, key)
+	}
+
+	return controller.NewPortForwardController(
+		usecase.NewPortForwardInteractor(podGates, deployGates, serviceGates),
+	)
+}
@@ -8,8 +8,9 @@ import (
 )
 
 type registry struct {
-	clients map[string]kubernetes.Interface
-	configs map[string]*rest.Config
+	clients   map[string]kubernetes.Interface
+	configs   map[string]*rest.Config
+	auditFile string
 }
 
 // Registry registry for all the layers
@@ -18,16 +19,32 @@ type Registry interface {
 	GetKubeConnection(context string) (kubernetes.Interface, *rest.Config)
 }
 
-// NewRegistry return a new registry
-func NewRegistry(clients map[string]kubernetes.Interface, configs map[string]*rest.Config) Registry {
-	return &registry{clients, configs}
+// NewRegistry return a new registry, the actions of the user are journaled in auditFile
+func NewRegistry(clients map[string]kubernetes.Interface, configs map[string]*rest.Config, auditFile string) Registry {
+	return &registry{clients, configs, auditFile}
 }
 
 func (r *registry) NewAppController() controller.AppController {
 	return controller.AppController{
-		Deployment: r.NewDeploymentController(),
-		Pod:        r.NewPodController(),
-		Namespace:  r.NewNamespaceController(),
+		Deployment:  r.NewDeploymentController(),
+		Pod:         r.NewPodController(),
+		Service:     r.NewServiceController(),
+		Secret:      r.NewSecretController(),
+		ConfigMap:   r.NewConfigMapController(),
+		Job:         r.NewJobController(),
+		CronJob:     r.NewCronJobController(),
+		StatefulSet: r.NewStatefulSetController(),
+		DaemonSet:   r.NewDaemonSetController(),
+		Ingress:     r.NewIngressController(),
+		HTTPRoute:   r.NewHTTPRouteController(),
+		PVC:         r.NewPersistentVolumeClaimController(),
+		PV:          r.NewPersistentVolumeController(),
+		HPA:         r.NewHorizontalPodAutoscalerController(),
+		Namespace:   r.NewNamespaceController(),
+		PortForward: r.NewPortForwardController(),
+		Health:      r.NewHealthController(),
+		Access:      r.NewAccessController(),
+		Audit:       r.NewAuditController(),
 	}
 }
 
@@ -0,0 +1,19 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewSecretController() controller.SecretController {
+	secretGates := map[string]interGate.SecretResourceGateway{}
+	for key, client := range r.clients {
+		secretGates[key] = k8s.NewSecretGateway(client, key)
+	}
+
+	return controller.NewSecretController(
+		usecase.NewSecretInteractor(secretGates),
+	)
+}
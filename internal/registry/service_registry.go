@@ -0,0 +1,19 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewServiceController() controller.ServiceController {
+	serviceGates := map[string]interGate.ServiceResourceGateway{}
+	for key, client := range r.clients {
+		serviceGates[key] = k8s.NewServiceGateway(client, key)
+	}
+
+	return controller.NewServiceController(
+		usecase.NewServiceInteractor(serviceGates),
+	)
+}
@@ -0,0 +1,30 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewPersistentVolumeClaimController() controller.ResourceLister {
+	claimGates := map[string]interGate.PersistentVolumeClaimResourceGateway{}
+	for key, client := range r.clients {
+		claimGates[key] = k8s.NewPersistentVolumeClaimGateway(client, key)
+	}
+
+	return controller.NewPersistentVolumeClaimController(
+		usecase.NewPersistentVolumeClaimInteractor(claimGates),
+	)
+}
+
+func (r *registry) NewPersistentVolumeController() controller.ResourceLister {
+	volumeGates := map[string]interGate.PersistentVolumeResourceGateway{}
+	for key, client := range r.clients {
+		volumeGates[key] = k8s.NewPersistentVolumeGateway(client, key)
+	}
+
+	return controller.NewPersistentVolumeController(
+		usecase.NewPersistentVolumeInteractor(volumeGates),
+	)
+}
@@ -0,0 +1,30 @@
+package registry
+
+import (
+	"lazykube/internal/adapter/controller"
+	"lazykube/internal/infrastructure/k8s"
+	"lazykube/internal/usecase"
+	interGate "lazykube/internal/usecase/port"
+)
+
+func (r *registry) NewStatefulSetController() controller.StatefulSetController {
+	statefulSetGates := map[string]interGate.StatefulSetResourceGateway{}
+	for key, client := range r.clients {
+		statefulSetGates[key] = k8s.NewStatefulSetGateway(client, key)
+	}
+
+	return controller.NewStatefulSetController(
+		usecase.NewStatefulSetInteractor(statefulSetGates),
+	)
+}
+
+func (r *registry) NewDaemonSetController() controller.DaemonSetController {
+	daemonSetGates := map[string]interGate.DaemonSetResourceGateway{}
+	for key, client := range r.clients {
+		daemonSetGates[key] = k8s.NewDaemonSetGateway(client, key)
+	}
+
+	return controller.NewDaemonSetController(
+		usecase.NewDaemonSetInteractor(daemonSetGates),
+	)
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+)
+
+type accessInteractor struct {
+	AccessGate map[string]port.AccessGateway
+}
+
+// AccessInteractor reviews what the current user can do in every context
+type AccessInteractor interface {
+	Review(ctx context.Context, clusterContext, namespace, group, resource string, permissions ...domain.Permission) (domain.Access, error)
+}
+
+// NewAccessInteractor return a new struct with accessInteractor
+func NewAccessInteractor(accessGate map[string]port.AccessGateway) AccessInteractor {
+	return &accessInteractor{
+		AccessGate: accessGate,
+	}
+}
+
+func (aI *accessInteractor) Review(ctx context.Context, clusterContext, namespace, group, resource string, permissions ...domain.Permission) (domain.Access, error) {
+	gateway, ok := aI.AccessGate[clusterContext]
+	if !ok {
+		return domain.Access{}, fmt.Errorf("no gateway found for context: %s", clusterContext)
+	}
+	return gateway.Review(ctx, namespace, group, resource, permissions...)
+}
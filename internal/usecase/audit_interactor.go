@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+)
+
+type auditInteractor struct {
+	Journal port.AuditJournal
+}
+
+// AuditInteractor records the actions of the user and searches them
+type AuditInteractor interface {
+	Record(entry domain.AuditEntry, err error)
+	List(filter string) ([]domain.AuditEntry, error)
+}
+
+// NewAuditInteractor return a new struct with auditInteractor
+func NewAuditInteractor(journal port.AuditJournal) AuditInteractor {
+	return &auditInteractor{
+		Journal: journal,
+	}
+}
+
+// Record appends the entry with the outcome of err. A failure of the journal
+// is only logged, it must not stop the action.
+func (aI *auditInteractor) Record(entry domain.AuditEntry, err error) {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	if entry.Outcome == "" {
+		switch {
+		case err == nil:
+			entry.Outcome = "ok"
+		case errors.Is(err, context.Canceled):
+			entry.Outcome = "cancelled"
+		default:
+			entry.Outcome = "error: " + err.Error()
+		}
+	}
+	if err := aI.Journal.Append(entry); err != nil {
+		slog.Error("writing the audit journal", "error", err)
+	}
+}
+
+// List returns the newest entries first, only the ones containing the filter
+// in any field when it isn't empty
+func (aI *auditInteractor) List(filter string) ([]domain.AuditEntry, error) {
+	entries, err := aI.Journal.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(entries)
+	if filter == "" {
+		return entries, nil
+	}
+	filter = strings.ToLower(filter)
+	return slices.DeleteFunc(entries, func(entry domain.AuditEntry) bool {
+		fields := strings.Join([]string{entry.Context, entry.Namespace, entry.Object, string(entry.Action), entry.Command, entry.Outcome}, " ")
+		return !strings.Contains(strings.ToLower(fields), filter)
+	}), nil
+}
@@ -0,0 +1,62 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"testing"
+)
+
+type memoryJournal struct {
+	entries []domain.AuditEntry
+}
+
+func (m *memoryJournal) Append(entry domain.AuditEntry) error {
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *memoryJournal) ReadAll() ([]domain.AuditEntry, error) {
+	return append([]domain.AuditEntry{}, m.entries...), nil
+}
+
+func TestAuditInteractor_RecordOutcome(t *testing.T) {
+	journal := &memoryJournal{}
+	ai := usecase.NewAuditInteractor(journal)
+
+	ai.Record(domain.AuditEntry{Object: "pod/a"}, nil)
+	ai.Record(domain.AuditEntry{Object: "pod/b"}, errors.New("forbidden"))
+	ai.Record(domain.AuditEntry{Object: "pod/c"}, context.Canceled)
+
+	expected := []string{"ok", "error: forbidden", "cancelled"}
+	for i, entry := range journal.entries {
+		if entry.Outcome != expected[i] {
+			t.Errorf("entry %d: expected outcome %q, got %q", i, expected[i], entry.Outcome)
+		}
+		if entry.Time.IsZero() {
+			t.Errorf("entry %d: expected the time to be set", i)
+		}
+	}
+}
+
+func TestAuditInteractor_ListFilter(t *testing.T) {
+	journal := &memoryJournal{}
+	ai := usecase.NewAuditInteractor(journal)
+	ai.Record(domain.AuditEntry{Context: "prod", Object: "pod/web", Action: domain.ActionExec}, nil)
+	ai.Record(domain.AuditEntry{Context: "dev", Object: "pod/db", Action: domain.ActionCopy}, nil)
+	ai.Record(domain.AuditEntry{Context: "prod", Object: "service/api", Action: domain.ActionPortForward}, nil)
+
+	entries, err := ai.List("PROD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Object != "service/api" || entries[1].Object != "pod/web" {
+		t.Fatalf("expected the prod entries newest first, got %+v", entries)
+	}
+
+	entries, _ = ai.List("")
+	if len(entries) != 3 {
+		t.Errorf("expected every entry without filter, got %d", len(entries))
+	}
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"path"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+type configMapInteractor struct {
+	ConfigMapRepo map[string]port.ConfigMapResourceGateway
+}
+
+// ConfigMapInteractor is an interface for connect to configmap interactor
+type ConfigMapInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.ConfigMap, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	GetKeys(ctx context.Context, configMapName, namespace, context string) ([]domain.ConfigMapKey, error)
+	GetValue(ctx context.Context, configMapName, namespace, context, key string) (string, error)
+	UpdateValue(ctx context.Context, configMapName, namespace, context, key, original, value string) error
+	GetReferences(ctx context.Context, configMapName, namespace, context string) ([]domain.ConfigMapReference, error)
+}
+
+// NewConfigMapInteractor return a new struct with configMapInteractor
+func NewConfigMapInteractor(configMapRepo map[string]port.ConfigMapResourceGateway) ConfigMapInteractor {
+	return &configMapInteractor{
+		ConfigMapRepo: configMapRepo,
+	}
+}
+
+func (ci *configMapInteractor) gateway(context string) (port.ConfigMapResourceGateway, error) {
+	gateway, ok := ci.ConfigMapRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway, nil
+}
+
+func (ci *configMapInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.ConfigMap, error) {
+	return getFromManyContext[domain.ConfigMap](ctx, ci.ConfigMapRepo, namespaces, contexts)
+}
+
+func (ci *configMapInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, err := ci.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+// GetKeys returns the keys sorted by name with the format detected for their value
+func (ci *configMapInteractor) GetKeys(ctx context.Context, configMapName, namespace, context string) ([]domain.ConfigMapKey, error) {
+	gateway, err := ci.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	configMap, err := gateway.GetByName(ctx, namespace, configMapName)
+	if err != nil {
+		return nil, err
+	}
+	keys := []domain.ConfigMapKey{}
+	for key, value := range configMap.Data {
+		keys = append(keys, domain.ConfigMapKey{Name: key, Size: len(value), Format: detectFormat(key, value)})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	return keys, nil
+}
+
+func (ci *configMapInteractor) GetValue(ctx context.Context, configMapName, namespace, context, key string) (string, error) {
+	gateway, err := ci.gateway(context)
+	if err != nil {
+		return "", err
+	}
+	configMap, err := gateway.GetByName(ctx, namespace, configMapName)
+	if err != nil {
+		return "", err
+	}
+	value, ok := configMap.Data[key]
+	if !ok {
+		return "", fmt.Errorf("configmap %s has no key %s", configMapName, key)
+	}
+	return value, nil
+}
+
+// UpdateValue saves the value of the key, a JSON or YAML value must still be valid.
+// original is the value the edit started from, it fails if the key changed since.
+func (ci *configMapInteractor) UpdateValue(ctx context.Context, configMapName, namespace, context, key, original, value string) error {
+	gateway, err := ci.gateway(context)
+	if err != nil {
+		return err
+	}
+	configMap, err := gateway.GetByName(ctx, namespace, configMapName)
+	if err != nil {
+		return err
+	}
+	if err := validateFormat(detectFormat(key, configMap.Data[key]), key, value); err != nil {
+		return err
+	}
+	return gateway.PatchValue(ctx, namespace, configMapName, key, original, value)
+}
+
+func (ci *configMapInteractor) GetReferences(ctx context.Context, configMapName, namespace, context string) ([]domain.ConfigMapReference, error) {
+	gateway, err := ci.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	return gateway.GetReferences(ctx, namespace, configMapName)
+}
+
+// detectFormat uses the extension of the key, and otherwise the content of the value
+func detectFormat(key, value string) string {
+	switch strings.ToLower(path.Ext(key)) {
+	case ".json":
+		return domain.FormatJSON
+	case ".yaml", ".yml":
+		return domain.FormatYAML
+	case ".properties", ".env", ".conf", ".ini":
+		return domain.FormatProperties
+	}
+	trimmed := strings.TrimSpace(value)
+	if (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)) {
+		return domain.FormatJSON
+	}
+	if isProperties(trimmed) {
+		return domain.FormatProperties
+	}
+	var document map[string]any
+	if strings.Contains(trimmed, ":") && yaml.Unmarshal([]byte(trimmed), &document) == nil && len(document) > 0 {
+		return domain.FormatYAML
+	}
+	return domain.FormatText
+}
+
+// isProperties reports if every line is a comment or a key=value pair
+func isProperties(value string) bool {
+	pairs := 0
+	scanner := bufio.NewScanner(strings.NewReader(value))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		name, _, found := strings.Cut(line, "=")
+		if !found || name == "" || strings.ContainsAny(name, " :{}") {
+			return false
+		}
+		pairs++
+	}
+	return pairs > 0
+}
+
+// validateFormat checks the value against the format of the value it replaces
+func validateFormat(format, key, value string) error {
+	switch format {
+	case domain.FormatJSON:
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("the value of %s is not valid JSON", key)
+		}
+	case domain.FormatYAML:
+		var document any
+		if err := yaml.Unmarshal([]byte(value), &document); err != nil {
+			return fmt.Errorf("the value of %s is not valid YAML: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package usecase_test
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"testing"
+)
+
+type mockConfigMapGateway struct {
+	port.ConfigMapResourceGateway
+	configMap domain.ConfigMap
+	patched   map[string]string
+}
+
+func (m *mockConfigMapGateway) GetByName(ctx context.Context, namespace, name string) (*domain.ConfigMap, error) {
+	return &m.configMap, nil
+}
+
+func (m *mockConfigMapGateway) PatchValue(ctx context.Context, namespace, name, key, original, value string) error {
+	m.patched[key] = value
+	return nil
+}
+
+func TestConfigMapInteractor_GetKeysFormats(t *testing.T) {
+	ci := usecase.NewConfigMapInteractor(map[string]port.ConfigMapResourceGateway{
+		"prod": &mockConfigMapGateway{configMap: domain.ConfigMap{Data: map[string]string{
+			"settings":       `{"level": "info"}`,
+			"app.yaml":       "level: info\n",
+			"nested":         "server:\n  port: 8080\n",
+			"app.properties": "level=info\n",
+			"env":            "# comment\nLEVEL=info\nMODE=fast\n",
+			"motd":           "Welcome to the cluster",
+		}}},
+	})
+
+	keys, err := ci.GetKeys(context.Background(), "app", "dev", "prod")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := map[string]string{
+		"settings":       domain.FormatJSON,
+		"app.yaml":       domain.FormatYAML,
+		"nested":         domain.FormatYAML,
+		"app.properties": domain.FormatProperties,
+		"env":            domain.FormatProperties,
+		"motd":           domain.FormatText,
+	}
+	for _, key := range keys {
+		if key.Format != expected[key.Name] {
+			t.Errorf("key %s: expected %s, got %s", key.Name, expected[key.Name], key.Format)
+		}
+	}
+	if keys[0].Name != "app.properties" {
+		t.Errorf("expected the keys sorted, got %+v", keys)
+	}
+}
+
+func TestConfigMapInteractor_UpdateValueValidates(t *testing.T) {
+	gateway := &mockConfigMapGateway{
+		configMap: domain.ConfigMap{Data: map[string]string{"settings": `{"level": "info"}`}},
+		patched:   map[string]string{},
+	}
+	ci := usecase.NewConfigMapInteractor(map[string]port.ConfigMapResourceGateway{"prod": gateway})
+
+	if err := ci.UpdateValue(context.Background(), "app", "dev", "prod", "settings", `{"level": "info"}`, `{"level":`); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+	if err := ci.UpdateValue(context.Background(), "app", "dev", "prod", "settings", `{"level": "info"}`, `{"level": "debug"}`); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if gateway.patched["settings"] != `{"level": "debug"}` {
+		t.Errorf("expected the value patched, got %v", gateway.patched)
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+type cronJobInteractor struct {
+	CronJobRepo map[string]port.CronJobResourceGateway
+}
+
+// CronJobInteractor is an interface for connect to cronjob interactor
+type CronJobInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.CronJob, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	SetSuspend(ctx context.Context, cronJobName, namespace, context string, suspend bool) error
+	Trigger(ctx context.Context, cronJobName, namespace, context string) (string, error)
+}
+
+// NewCronJobInteractor return a new struct with cronJobInteractor
+func NewCronJobInteractor(cronJobRepo map[string]port.CronJobResourceGateway) CronJobInteractor {
+	return &cronJobInteractor{
+		CronJobRepo: cronJobRepo,
+	}
+}
+
+// GetFromManyContext lists the cronjobs with the time of their next run
+func (ci *cronJobInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.CronJob, error) {
+	results, err := getFromManyContext[domain.CronJob](ctx, ci.CronJobRepo, namespaces, contexts)
+	now := time.Now()
+	for _, cronJobs := range results {
+		for i := range cronJobs {
+			cronJobs[i].NextRun = nextRun(cronJobs[i], now)
+		}
+	}
+	return results, err
+}
+
+func (ci *cronJobInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, ok := ci.CronJobRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+func (ci *cronJobInteractor) SetSuspend(ctx context.Context, cronJobName, namespace, context string, suspend bool) error {
+	gateway, ok := ci.CronJobRepo[context]
+	if !ok {
+		return fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.SetSuspend(ctx, namespace, cronJobName, suspend)
+}
+
+func (ci *cronJobInteractor) Trigger(ctx context.Context, cronJobName, namespace, context string) (string, error) {
+	gateway, ok := ci.CronJobRepo[context]
+	if !ok {
+		return "", fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.Trigger(ctx, namespace, cronJobName)
+}
+
+// nextRun returns the next time of the schedule in the time zone of the cronjob,
+// zero when it is suspended or the schedule can't be parsed
+func nextRun(cronJob domain.CronJob, now time.Time) time.Time {
+	if cronJob.Suspend {
+		return time.Time{}
+	}
+	spec := cronJob.Schedule
+	if cronJob.TimeZone != "" {
+		spec = "CRON_TZ=" + cronJob.TimeZone + " " + spec
+	}
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return time.Time{}
+	}
+	return schedule.Next(now)
+}
@@ -0,0 +1,54 @@
+package usecase_test
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"testing"
+	"time"
+)
+
+type mockCronJobGateway struct {
+	port.CronJobResourceGateway
+	cronJobs []domain.CronJob
+}
+
+func (m *mockCronJobGateway) GetAll(ctx context.Context, namespace string) ([]domain.CronJob, error) {
+	return m.cronJobs, nil
+}
+
+func TestCronJobInteractor_NextRun(t *testing.T) {
+	ci := usecase.NewCronJobInteractor(map[string]port.CronJobResourceGateway{
+		"prod": &mockCronJobGateway{cronJobs: []domain.CronJob{
+			{Name: "often", Schedule: "*/5 * * * *"},
+			{Name: "tokyo", Schedule: "0 9 * * *", TimeZone: "Asia/Tokyo"},
+			{Name: "paused", Schedule: "*/5 * * * *", Suspend: true},
+			{Name: "broken", Schedule: "not a schedule"},
+		}},
+	})
+
+	now := time.Now()
+	results, err := ci.GetFromManyContext(context.Background(), []string{"dev"}, []string{"prod"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	byName := map[string]domain.CronJob{}
+	for _, cronJob := range results["prod"] {
+		byName[cronJob.Name] = cronJob
+	}
+
+	if next := byName["often"].NextRun; next.Before(now) || next.Sub(now) > 5*time.Minute {
+		t.Errorf("expected the next run in 5 minutes, got %v", next)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("no time zone database")
+	}
+	if next := byName["tokyo"].NextRun.In(tokyo); next.Hour() != 9 || next.Minute() != 0 {
+		t.Errorf("expected 9:00 in Tokyo, got %v", next)
+	}
+	if !byName["paused"].NextRun.IsZero() || !byName["broken"].NextRun.IsZero() {
+		t.Errorf("expected no next run when suspended or invalid, got %+v", byName)
+	}
+}
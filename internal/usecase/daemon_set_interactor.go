@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+)
+
+type daemonSetInteractor struct {
+	DaemonSetRepo map[string]port.DaemonSetResourceGateway
+}
+
+// DaemonSetInteractor is an interface for connect to daemonset interactor
+type DaemonSetInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.DaemonSet, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	GetPods(ctx context.Context, daemonSetName, namespace, context string) ([]domain.Pod, error)
+	Restart(ctx context.Context, daemonSetName, namespace, context string) error
+}
+
+// NewDaemonSetInteractor return a new struct with daemonSetInteractor
+func NewDaemonSetInteractor(daemonSetRepo map[string]port.DaemonSetResourceGateway) DaemonSetInteractor {
+	return &daemonSetInteractor{
+		DaemonSetRepo: daemonSetRepo,
+	}
+}
+
+func (di *daemonSetInteractor) gateway(context string) (port.DaemonSetResourceGateway, error) {
+	gateway, ok := di.DaemonSetRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway, nil
+}
+
+func (di *daemonSetInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.DaemonSet, error) {
+	return getFromManyContext[domain.DaemonSet](ctx, di.DaemonSetRepo, namespaces, contexts)
+}
+
+func (di *daemonSetInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, err := di.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+func (di *daemonSetInteractor) GetPods(ctx context.Context, daemonSetName, namespace, context string) ([]domain.Pod, error) {
+	gateway, err := di.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	return gateway.GetPods(ctx, daemonSetName, namespace)
+}
+
+func (di *daemonSetInteractor) Restart(ctx context.Context, daemonSetName, namespace, context string) error {
+	gateway, err := di.gateway(context)
+	if err != nil {
+		return err
+	}
+	return gateway.Restart(ctx, namespace, daemonSetName)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"lazykube/internal/domain"
 	"lazykube/internal/usecase/port"
-	"sync"
 )
 
 type deploymentInteractor struct {
@@ -47,65 +46,11 @@ func (di *deploymentInteractor) GetYaml(ctx context.Context, namespace string, n
 }
 
 func (di *deploymentInteractor) GetAll(ctx context.Context, namespace string) (map[string][]domain.Deployment, error) {
-	var (
-		deploymentLists = make(map[string][]domain.Deployment)
-		mu              sync.Mutex
-		wg              sync.WaitGroup
-		firstErr        error
-	)
-
-	for key, repo := range di.DeploymentRepo {
-		wg.Go(func() {
-			deployments, err := repo.GetAll(ctx, namespace)
-
-			mu.Lock()
-			defer mu.Unlock()
-			if err != nil {
-				if firstErr == nil {
-					firstErr = fmt.Errorf("context %s: %w", key, err)
-				}
-				fmt.Printf("Error fetching deployments from context %s: %v\n", key, err)
-				return
-			}
-			deploymentLists[key] = deployments
-		})
-	}
-	wg.Wait()
-	return deploymentLists, firstErr
+	return getAllContexts[domain.Deployment](ctx, di.DeploymentRepo, namespace)
 }
 
 func (di *deploymentInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.Deployment, error) {
-	var (
-		deploymentLists = make(map[string][]domain.Deployment)
-		mu              sync.Mutex
-		wg              sync.WaitGroup
-		firstErr        error
-	)
-
-	for _, clusterCtx := range contexts {
-		repo, ok := di.DeploymentRepo[clusterCtx]
-		if !ok {
-			continue
-		}
-		for _, ns := range namespaces {
-			wg.Go(func() {
-				deployments, err := repo.GetAll(ctx, ns)
-
-				mu.Lock()
-				defer mu.Unlock()
-				if err != nil {
-					if firstErr == nil {
-						firstErr = fmt.Errorf("context %s, namespace %s: %w", clusterCtx, ns, err)
-					}
-					fmt.Printf("Error fetching deployments from context %s, namespace %s: %v\n", clusterCtx, ns, err)
-					return
-				}
-				deploymentLists[clusterCtx] = append(deploymentLists[clusterCtx], deployments...)
-			})
-		}
-	}
-	wg.Wait()
-	return deploymentLists, firstErr
+	return getFromManyContext[domain.Deployment](ctx, di.DeploymentRepo, namespaces, contexts)
 }
 
 func (di *deploymentInteractor) GetAllOneContext(ctx context.Context, namespace string, context string) ([]domain.Deployment, error) {
@@ -113,10 +58,5 @@ func (di *deploymentInteractor) GetAllOneContext(ctx context.Context, namespace
 	if !ok {
 		return nil, fmt.Errorf("no gateway found for context: %s", context)
 	}
-	deployments, err := repo.GetAll(ctx, namespace)
-	if err != nil {
-		fmt.Printf("Error fetching deployments from context %s: %v\n", context, err)
-		return nil, err
-	}
-	return deployments, nil
+	return repo.GetAll(ctx, namespace)
 }
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"sort"
+	"sync"
+)
+
+type healthInteractor struct {
+	HealthGate map[string]port.HealthGateway
+}
+
+// HealthInteractor checks the health of every context
+type HealthInteractor interface {
+	CheckAll(ctx context.Context) []domain.ClusterHealth
+}
+
+// NewHealthInteractor return a new struct with healthInteractor
+func NewHealthInteractor(healthGate map[string]port.HealthGateway) HealthInteractor {
+	return &healthInteractor{
+		HealthGate: healthGate,
+	}
+}
+
+// CheckAll checks the contexts in parallel and returns them sorted by context
+func (hI *healthInteractor) CheckAll(ctx context.Context) []domain.ClusterHealth {
+	var (
+		results = make([]domain.ClusterHealth, 0, len(hI.HealthGate))
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+	for _, gateway := range hI.HealthGate {
+		wg.Go(func() {
+			health := gateway.Check(ctx)
+			mu.Lock()
+			defer mu.Unlock()
+			results = append(results, health)
+		})
+	}
+	wg.Wait()
+	sort.Slice(results, func(i, j int) bool { return results[i].Context < results[j].Context })
+	return results
+}
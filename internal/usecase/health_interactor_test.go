@@ -0,0 +1,32 @@
+package usecase_test
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"testing"
+)
+
+type mockHealthGateway struct {
+	health domain.ClusterHealth
+}
+
+func (m *mockHealthGateway) Check(ctx context.Context) domain.ClusterHealth {
+	return m.health
+}
+
+func TestHealthInteractor_CheckAll(t *testing.T) {
+	hi := usecase.NewHealthInteractor(map[string]port.HealthGateway{
+		"b": &mockHealthGateway{health: domain.ClusterHealth{Context: "b", Status: domain.ClusterUnreachable}},
+		"a": &mockHealthGateway{health: domain.ClusterHealth{Context: "a", Status: domain.ClusterHealthy}},
+	})
+
+	results := hi.CheckAll(context.Background())
+	if len(results) != 2 || results[0].Context != "a" || results[1].Context != "b" {
+		t.Fatalf("expected the checks sorted by context, got %+v", results)
+	}
+	if results[1].Status != domain.ClusterUnreachable {
+		t.Errorf("expected the unreachable status, got %s", results[1].Status)
+	}
+}
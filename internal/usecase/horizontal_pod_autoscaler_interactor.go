@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+)
+
+type horizontalPodAutoscalerInteractor struct {
+	HorizontalPodAutoscalerRepo map[string]port.HorizontalPodAutoscalerResourceGateway
+}
+
+// HorizontalPodAutoscalerInteractor is an interface for connect to horizontalpodautoscaler interactor
+type HorizontalPodAutoscalerInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.HorizontalPodAutoscaler, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	GetAutoscaler(ctx context.Context, autoscalerName, namespace, context string) (*domain.HorizontalPodAutoscaler, error)
+	GetForTarget(ctx context.Context, kind, name, namespace, context string) (*domain.HorizontalPodAutoscaler, error)
+	UpdateReplicas(ctx context.Context, autoscalerName, namespace, context string, minReplicas, maxReplicas int32) error
+}
+
+// NewHorizontalPodAutoscalerInteractor return a new struct with horizontalPodAutoscalerInteractor
+func NewHorizontalPodAutoscalerInteractor(autoscalerRepo map[string]port.HorizontalPodAutoscalerResourceGateway) HorizontalPodAutoscalerInteractor {
+	return &horizontalPodAutoscalerInteractor{
+		HorizontalPodAutoscalerRepo: autoscalerRepo,
+	}
+}
+
+func (hi *horizontalPodAutoscalerInteractor) gateway(context string) (port.HorizontalPodAutoscalerResourceGateway, error) {
+	gateway, ok := hi.HorizontalPodAutoscalerRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway, nil
+}
+
+func (hi *horizontalPodAutoscalerInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.HorizontalPodAutoscaler, error) {
+	return getFromManyContext[domain.HorizontalPodAutoscaler](ctx, hi.HorizontalPodAutoscalerRepo, namespaces, contexts)
+}
+
+func (hi *horizontalPodAutoscalerInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, err := hi.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+func (hi *horizontalPodAutoscalerInteractor) GetAutoscaler(ctx context.Context, autoscalerName, namespace, context string) (*domain.HorizontalPodAutoscaler, error) {
+	gateway, err := hi.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	return gateway.GetByName(ctx, namespace, autoscalerName)
+}
+
+// GetForTarget returns the autoscaler of the workload, nil when it has none
+func (hi *horizontalPodAutoscalerInteractor) GetForTarget(ctx context.Context, kind, name, namespace, context string) (*domain.HorizontalPodAutoscaler, error) {
+	gateway, err := hi.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	autoscalers, err := gateway.GetAll(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, autoscaler := range autoscalers {
+		if autoscaler.TargetKind == kind && autoscaler.TargetName == name {
+			return &autoscaler, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateReplicas sets the bounds of the replicas, rejecting the ones the api server would
+func (hi *horizontalPodAutoscalerInteractor) UpdateReplicas(ctx context.Context, autoscalerName, namespace, context string, minReplicas, maxReplicas int32) error {
+	if minReplicas < 1 {
+		return fmt.Errorf("invalid min replicas %d, must be at least 1", minReplicas)
+	}
+	if maxReplicas < minReplicas {
+		return fmt.Errorf("invalid max replicas %d, must be at least the min replicas %d", maxReplicas, minReplicas)
+	}
+	gateway, err := hi.gateway(context)
+	if err != nil {
+		return err
+	}
+	return gateway.SetReplicas(ctx, namespace, autoscalerName, minReplicas, maxReplicas)
+}
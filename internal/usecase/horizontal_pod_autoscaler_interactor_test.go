@@ -0,0 +1,67 @@
+package usecase_test
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"testing"
+)
+
+type mockAutoscalerGateway struct {
+	port.ResourceGateway[domain.HorizontalPodAutoscaler]
+	autoscalers []domain.HorizontalPodAutoscaler
+	patched     []int32
+}
+
+func (m *mockAutoscalerGateway) GetAll(ctx context.Context, namespace string) ([]domain.HorizontalPodAutoscaler, error) {
+	return m.autoscalers, nil
+}
+
+func (m *mockAutoscalerGateway) SetReplicas(ctx context.Context, namespace, name string, minReplicas, maxReplicas int32) error {
+	m.patched = []int32{minReplicas, maxReplicas}
+	return nil
+}
+
+func TestHorizontalPodAutoscalerInteractor_GetForTarget(t *testing.T) {
+	hi := usecase.NewHorizontalPodAutoscalerInteractor(map[string]port.HorizontalPodAutoscalerResourceGateway{
+		"prod": &mockAutoscalerGateway{autoscalers: []domain.HorizontalPodAutoscaler{
+			{Name: "db", TargetKind: "StatefulSet", TargetName: "web"},
+			{Name: "web", TargetKind: "Deployment", TargetName: "web"},
+		}},
+	})
+
+	autoscaler, err := hi.GetForTarget(context.Background(), "Deployment", "web", "dev", "prod")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if autoscaler == nil || autoscaler.Name != "web" {
+		t.Errorf("expected the autoscaler of the deployment, got %+v", autoscaler)
+	}
+
+	autoscaler, err = hi.GetForTarget(context.Background(), "Deployment", "api", "dev", "prod")
+	if err != nil || autoscaler != nil {
+		t.Errorf("expected no autoscaler, got %+v, %v", autoscaler, err)
+	}
+}
+
+func TestHorizontalPodAutoscalerInteractor_UpdateReplicas(t *testing.T) {
+	gateway := &mockAutoscalerGateway{}
+	hi := usecase.NewHorizontalPodAutoscalerInteractor(map[string]port.HorizontalPodAutoscalerResourceGateway{"prod": gateway})
+
+	if err := hi.UpdateReplicas(context.Background(), "web", "dev", "prod", 0, 5); err == nil {
+		t.Error("expected an error for min replicas 0")
+	}
+	if err := hi.UpdateReplicas(context.Background(), "web", "dev", "prod", 5, 3); err == nil {
+		t.Error("expected an error for max replicas below min replicas")
+	}
+	if gateway.patched != nil {
+		t.Fatalf("expected no patch for invalid replicas, got %v", gateway.patched)
+	}
+	if err := hi.UpdateReplicas(context.Background(), "web", "dev", "prod", 2, 8); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(gateway.patched) != 2 || gateway.patched[0] != 2 || gateway.patched[1] != 8 {
+		t.Errorf("expected the replicas 2 to 8 patched, got %v", gateway.patched)
+	}
+}
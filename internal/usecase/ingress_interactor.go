@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"strconv"
+	"time"
+)
+
+type ingressInteractor struct {
+	IngressRepo map[string]port.IngressResourceGateway
+	ServiceRepo map[string]port.ServiceResourceGateway
+	SecretRepo  map[string]port.SecretResourceGateway
+}
+
+// IngressInteractor lists the ingresses or the httproutes and resolves where they route
+type IngressInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.Ingress, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	GetRouting(ctx context.Context, name, namespace, context string) (*domain.IngressRouting, error)
+}
+
+// NewIngressInteractor return a new struct with ingressInteractor, the services
+// and the secrets resolve the backends and the certificates of the routes
+func NewIngressInteractor(
+	ingressRepo map[string]port.IngressResourceGateway,
+	serviceRepo map[string]port.ServiceResourceGateway,
+	secretRepo map[string]port.SecretResourceGateway,
+) IngressInteractor {
+	return &ingressInteractor{
+		IngressRepo: ingressRepo,
+		ServiceRepo: serviceRepo,
+		SecretRepo:  secretRepo,
+	}
+}
+
+func (ii *ingressInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.Ingress, error) {
+	return getFromManyContext[domain.Ingress](ctx, ii.IngressRepo, namespaces, contexts)
+}
+
+func (ii *ingressInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, ok := ii.IngressRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+// GetRouting resolves for every route if its service and port exist and how many
+// endpoints are ready, and the expiry of every TLS certificate
+func (ii *ingressInteractor) GetRouting(ctx context.Context, name, namespace, context string) (*domain.IngressRouting, error) {
+	gateway, ok := ii.IngressRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	ingress, err := gateway.GetByName(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	serviceGateway, ok := ii.ServiceRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+
+	routing := &domain.IngressRouting{}
+	for _, route := range ingress.Routes {
+		routing.Routes = append(routing.Routes, resolveRoute(ctx, serviceGateway, route))
+	}
+	for _, tls := range ingress.TLS {
+		status := domain.TLSStatus{IngressTLS: tls}
+		secretNamespace := namespace
+		if tls.Namespace != "" {
+			secretNamespace = tls.Namespace
+		}
+		secretGateway, ok := ii.SecretRepo[context]
+		if !ok {
+			status.Error = fmt.Sprintf("no gateway found for context: %s", context)
+		} else if notAfter, err := certificateExpiry(ctx, secretGateway, secretNamespace, tls.Secret); err != nil {
+			status.Error = err.Error()
+		} else {
+			status.NotAfter = notAfter
+		}
+		routing.TLS = append(routing.TLS, status)
+	}
+	return routing, nil
+}
+
+func resolveRoute(ctx context.Context, gateway port.ServiceResourceGateway, route domain.IngressRoute) domain.RouteStatus {
+	status := domain.RouteStatus{IngressRoute: route}
+	service, err := gateway.GetByName(ctx, route.Namespace, route.Service)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.ServiceFound = true
+	status.PortFound = route.Port == ""
+	for _, servicePort := range service.Ports {
+		if route.Port == servicePort.Name || route.Port == strconv.Itoa(int(servicePort.Port)) {
+			status.PortFound = true
+		}
+	}
+	endpoints, err := gateway.GetEndpoints(ctx, route.Namespace, route.Service)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.Endpoints = len(endpoints)
+	for _, endpoint := range endpoints {
+		if endpoint.Ready {
+			status.ReadyEndpoints++
+		}
+	}
+	return status
+}
+
+// certificateExpiry returns the earliest expiry of the certificates of the TLS secret
+func certificateExpiry(ctx context.Context, gateway port.SecretResourceGateway, namespace, name string) (time.Time, error) {
+	if name == "" {
+		return time.Time{}, errors.New("no secret, the controller default certificate is used")
+	}
+	data, err := gateway.GetData(ctx, namespace, name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var notAfter time.Time
+	for rest := data["tls.crt"]; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid certificate in secret %s: %w", name, err)
+		}
+		if notAfter.IsZero() || certificate.NotAfter.Before(notAfter) {
+			notAfter = certificate.NotAfter
+		}
+	}
+	if notAfter.IsZero() {
+		return time.Time{}, fmt.Errorf("no certificate in tls.crt of secret %s", name)
+	}
+	return notAfter, nil
+}
@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"testing"
+	"time"
+)
+
+type mockIngressGateway struct {
+	port.ResourceGateway[domain.Ingress]
+	ingress domain.Ingress
+}
+
+func (m *mockIngressGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Ingress, error) {
+	return &m.ingress, nil
+}
+
+type mockBackendGateway struct {
+	port.ResourceGateway[domain.Service]
+	services  map[string]domain.Service
+	endpoints map[string][]domain.ServiceEndpoint
+}
+
+func (m *mockBackendGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Service, error) {
+	service, ok := m.services[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &service, nil
+}
+
+func (m *mockBackendGateway) GetEndpoints(ctx context.Context, namespace, name string) ([]domain.ServiceEndpoint, error) {
+	return m.endpoints[name], nil
+}
+
+func TestIngressInteractor_GetRouting(t *testing.T) {
+	notAfter := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	ii := usecase.NewIngressInteractor(
+		map[string]port.IngressResourceGateway{"prod": &mockIngressGateway{ingress: domain.Ingress{
+			Routes: []domain.IngressRoute{
+				{Host: "example.com", Path: "/", Service: "web", Port: "http"},
+				{Host: "example.com", Path: "/api", Service: "api", Port: "9090"},
+				{Host: "example.com", Path: "/old", Service: "gone", Port: "80"},
+			},
+			TLS: []domain.IngressTLS{{Hosts: []string{"example.com"}, Secret: "web-tls"}},
+		}}},
+		map[string]port.ServiceResourceGateway{"prod": &mockBackendGateway{
+			services: map[string]domain.Service{
+				"web": {Ports: []domain.ServicePort{{Name: "http", Port: 80}}},
+				"api": {Ports: []domain.ServicePort{{Port: 8080}}},
+			},
+			endpoints: map[string][]domain.ServiceEndpoint{
+				"web": {{Ready: true}, {Ready: false}},
+			},
+		}},
+		map[string]port.SecretResourceGateway{"prod": &mockSecretGateway{data: map[string][]byte{
+			"tls.crt": testCertificate(t, notAfter),
+		}}},
+	)
+
+	routing, err := ii.GetRouting(context.Background(), "web", "dev", "prod")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(routing.Routes) != 3 {
+		t.Fatalf("expected 3 routes, got %+v", routing.Routes)
+	}
+	if web := routing.Routes[0]; !web.ServiceFound || !web.PortFound || web.Endpoints != 2 || web.ReadyEndpoints != 1 {
+		t.Errorf("unexpected web route %+v", web)
+	}
+	if api := routing.Routes[1]; !api.ServiceFound || api.PortFound {
+		t.Errorf("expected the port of the api route missing, got %+v", api)
+	}
+	if gone := routing.Routes[2]; gone.ServiceFound || gone.Error == "" {
+		t.Errorf("expected the service of the old route missing, got %+v", gone)
+	}
+	if len(routing.TLS) != 1 || !routing.TLS[0].NotAfter.Equal(notAfter) || routing.TLS[0].Error != "" {
+		t.Errorf("unexpected tls %+v", routing.TLS)
+	}
+}
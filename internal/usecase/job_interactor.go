@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+)
+
+type jobInteractor struct {
+	JobRepo map[string]port.JobResourceGateway
+}
+
+// JobInteractor is an interface for connect to job interactor
+type JobInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.Job, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	GetPods(ctx context.Context, jobName, namespace, context string) ([]domain.Pod, error)
+}
+
+// NewJobInteractor return a new struct with jobInteractor
+func NewJobInteractor(jobRepo map[string]port.JobResourceGateway) JobInteractor {
+	return &jobInteractor{
+		JobRepo: jobRepo,
+	}
+}
+
+func (ji *jobInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.Job, error) {
+	return getFromManyContext[domain.Job](ctx, ji.JobRepo, namespaces, contexts)
+}
+
+func (ji *jobInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, ok := ji.JobRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+func (ji *jobInteractor) GetPods(ctx context.Context, jobName, namespace, context string) ([]domain.Pod, error) {
+	gateway, ok := ji.JobRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetPods(ctx, jobName, namespace)
+}
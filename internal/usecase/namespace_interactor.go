@@ -2,7 +2,12 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"lazykube/internal/domain"
 	"lazykube/internal/usecase/port"
+	"slices"
+	"sort"
+	"sync"
 )
 
 type namespaceInteractor struct {
@@ -11,6 +16,7 @@ type namespaceInteractor struct {
 
 type NamespaceInteractor interface {
 	GetAll(ctx context.Context, clusterContext string) ([]string, error)
+	GetUnion(ctx context.Context, contexts []string) ([]domain.Namespace, error)
 }
 
 func NewNamespaceInteractor(namespaceGate map[string]port.NamespaceGateway) NamespaceInteractor {
@@ -20,11 +26,51 @@ func NewNamespaceInteractor(namespaceGate map[string]port.NamespaceGateway) Name
 }
 
 func (nI namespaceInteractor) GetAll(ctx context.Context, clusterContext string) ([]string, error) {
-	if clusterContext == "all" {
-		return []string{
-			"all",
-			"default",
-		}, nil
+	gateway, ok := nI.NamespaceGate[clusterContext]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", clusterContext)
 	}
-	return nI.NamespaceGate[clusterContext].GetAll(ctx)
+	return gateway.GetAll(ctx)
+}
+
+// GetUnion fetches the namespaces of the contexts in parallel and returns them
+// sorted by name, each one with the contexts that have it
+func (nI namespaceInteractor) GetUnion(ctx context.Context, contexts []string) ([]domain.Namespace, error) {
+	var (
+		owners   = map[string][]string{}
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+	)
+
+	for _, clusterCtx := range contexts {
+		gateway, ok := nI.NamespaceGate[clusterCtx]
+		if !ok {
+			continue
+		}
+		wg.Go(func() {
+			names, err := gateway.GetAll(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("context %s: %w", clusterCtx, err)
+				}
+				return
+			}
+			for _, name := range names {
+				owners[name] = append(owners[name], clusterCtx)
+			}
+		})
+	}
+	wg.Wait()
+
+	namespaces := make([]domain.Namespace, 0, len(owners))
+	for name, clusterCtxs := range owners {
+		slices.Sort(clusterCtxs)
+		namespaces = append(namespaces, domain.Namespace{Name: name, Contexts: clusterCtxs})
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Name < namespaces[j].Name })
+	return namespaces, firstErr
 }
@@ -0,0 +1,49 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"reflect"
+	"testing"
+)
+
+type mockNamespaceGateway struct {
+	namespaces []string
+	err        error
+}
+
+func (m *mockNamespaceGateway) GetAll(ctx context.Context) ([]string, error) {
+	return m.namespaces, m.err
+}
+
+func TestNamespaceInteractor_GetUnion(t *testing.T) {
+	ni := usecase.NewNamespaceInteractor(map[string]port.NamespaceGateway{
+		"a": &mockNamespaceGateway{namespaces: []string{"default", "web"}},
+		"b": &mockNamespaceGateway{namespaces: []string{"default", "db"}},
+		"c": &mockNamespaceGateway{err: errors.New("unreachable")},
+	})
+
+	namespaces, err := ni.GetUnion(context.Background(), []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := []domain.Namespace{
+		{Name: "db", Contexts: []string{"b"}},
+		{Name: "default", Contexts: []string{"a", "b"}},
+		{Name: "web", Contexts: []string{"a"}},
+	}
+	if !reflect.DeepEqual(namespaces, expected) {
+		t.Errorf("expected %v, got %v", expected, namespaces)
+	}
+
+	namespaces, err = ni.GetUnion(context.Background(), []string{"a", "c"})
+	if err == nil {
+		t.Errorf("expected the error of the unreachable context")
+	}
+	if len(namespaces) != 2 {
+		t.Errorf("expected the namespaces of the reachable context, got %v", namespaces)
+	}
+}
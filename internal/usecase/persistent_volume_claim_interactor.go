@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+)
+
+type persistentVolumeClaimInteractor struct {
+	PersistentVolumeClaimRepo map[string]port.PersistentVolumeClaimResourceGateway
+}
+
+// PersistentVolumeClaimInteractor is an interface for connect to persistentvolumeclaim interactor
+type PersistentVolumeClaimInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.PersistentVolumeClaim, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+}
+
+// NewPersistentVolumeClaimInteractor return a new struct with persistentVolumeClaimInteractor
+func NewPersistentVolumeClaimInteractor(claimRepo map[string]port.PersistentVolumeClaimResourceGateway) PersistentVolumeClaimInteractor {
+	return &persistentVolumeClaimInteractor{
+		PersistentVolumeClaimRepo: claimRepo,
+	}
+}
+
+func (pi *persistentVolumeClaimInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.PersistentVolumeClaim, error) {
+	return getFromManyContext[domain.PersistentVolumeClaim](ctx, pi.PersistentVolumeClaimRepo, namespaces, contexts)
+}
+
+func (pi *persistentVolumeClaimInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, ok := pi.PersistentVolumeClaimRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+)
+
+type persistentVolumeInteractor struct {
+	PersistentVolumeRepo map[string]port.PersistentVolumeResourceGateway
+}
+
+// PersistentVolumeInteractor is an interface for connect to persistentvolume interactor
+type PersistentVolumeInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.PersistentVolume, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+}
+
+// NewPersistentVolumeInteractor return a new struct with persistentVolumeInteractor
+func NewPersistentVolumeInteractor(volumeRepo map[string]port.PersistentVolumeResourceGateway) PersistentVolumeInteractor {
+	return &persistentVolumeInteractor{
+		PersistentVolumeRepo: volumeRepo,
+	}
+}
+
+// GetFromManyContext lists the volumes once by context whatever the namespaces,
+// they are cluster-scoped
+func (pi *persistentVolumeInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.PersistentVolume, error) {
+	return getFromManyContext[domain.PersistentVolume](ctx, pi.PersistentVolumeRepo, []string{domain.AllNamespaces}, contexts)
+}
+
+func (pi *persistentVolumeInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, ok := pi.PersistentVolumeRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
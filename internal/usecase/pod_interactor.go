@@ -1,13 +1,13 @@
 package usecase
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"lazykube/internal/domain"
 	"lazykube/internal/usecase/port"
-	"sync"
+	"strings"
 
 	"k8s.io/client-go/tools/remotecommand"
 )
@@ -22,9 +22,13 @@ type PodInteractor interface {
 	GetAllOneContext(context.Context, string, string) ([]domain.Pod, error)
 	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.Pod, error)
 	GetYaml(context.Context, string, string, string) ([]byte, error)
-	Exec(ctx context.Context, podName, namespace, context, command, containerName string, dryRun bool, options remotecommand.StreamOptions) error
+	GetPod(ctx context.Context, podName, namespace, context string) (*domain.Pod, error)
+	Exec(ctx context.Context, podName, namespace, context string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error
+	ExecShell(ctx context.Context, podName, namespace, context string, shells []string, containerName string, options remotecommand.StreamOptions, started func(shell string)) (string, error)
 	GetLogs(ctx context.Context, podName, namespace, context, containerName string) (io.ReadCloser, error)
-	PortForward(ctx context.Context, podName, namespace, context string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error)
+	Debug(ctx context.Context, podName, namespace, context, image, targetContainer string) (string, error)
+	CopyFrom(ctx context.Context, podName, namespace, context, containerName, remotePath, localPath string, progress func(int64)) error
+	CopyTo(ctx context.Context, podName, namespace, context, containerName, localPath, remotePath string, progress func(int64)) error
 }
 
 // NewPodInteractor return an struct of tyoe operatorInteractor
@@ -42,95 +46,101 @@ func (pi *podInteractor) GetLogs(ctx context.Context, podName, namespace, contex
 	return gateway.GetLogs(ctx, podName, namespace, containerName)
 }
 
-func (pi *podInteractor) Exec(ctx context.Context, podName, namespace, context, command, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
+func (pi *podInteractor) CopyFrom(ctx context.Context, podName, namespace, context, containerName, remotePath, localPath string, progress func(int64)) error {
 	gateway := pi.PodRepo[context]
 	if gateway == nil {
 		return fmt.Errorf("no gateway found for context: %s", context)
 	}
-	return gateway.Exec(ctx, podName, namespace, command, containerName, dryRun, options)
+	return gateway.CopyFrom(ctx, podName, namespace, containerName, remotePath, localPath, progress)
 }
 
-func (pi *podInteractor) GetAll(ctx context.Context, namespace string) (map[string][]domain.Pod, error) {
-	var (
-		podLists = make(map[string][]domain.Pod)
-		mu       sync.Mutex
-		wg       sync.WaitGroup
-		firstErr error
-	)
-
-	for key, repo := range pi.PodRepo {
-		wg.Go(func() {
-			pods, err := repo.GetAll(ctx, namespace)
-
-			mu.Lock()
-			defer mu.Unlock()
-			if err != nil {
-				if firstErr == nil {
-					firstErr = fmt.Errorf("context %s: %w", key, err)
-				}
-				fmt.Printf("Error fetching pods from context %s: %v\n", key, err)
-				return
-			}
-			podLists[key] = pods
-		})
+func (pi *podInteractor) CopyTo(ctx context.Context, podName, namespace, context, containerName, localPath, remotePath string, progress func(int64)) error {
+	gateway := pi.PodRepo[context]
+	if gateway == nil {
+		return fmt.Errorf("no gateway found for context: %s", context)
 	}
-	wg.Wait()
-	return podLists, firstErr
+	return gateway.CopyTo(ctx, podName, namespace, containerName, localPath, remotePath, progress)
 }
 
-func (pi *podInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.Pod, error) {
-	var (
-		podLists = make(map[string][]domain.Pod)
-		mu       sync.Mutex
-		wg       sync.WaitGroup
-		firstErr error
-	)
-
-	for _, clusterCtx := range contexts {
-		repo, ok := pi.PodRepo[clusterCtx]
-		if !ok {
-			continue
-		}
-		for _, ns := range namespaces {
-			wg.Go(func() {
-				pods, err := repo.GetAll(ctx, ns)
-
-				mu.Lock()
-				defer mu.Unlock()
-				if err != nil {
-					if firstErr == nil {
-						firstErr = fmt.Errorf("context %s, namespace %s: %w", clusterCtx, ns, err)
-					}
-					fmt.Printf("Error fetching pods from context %s, namespace %s: %v\n", clusterCtx, ns, err)
-					return
-				}
-				podLists[clusterCtx] = append(podLists[clusterCtx], pods...)
-			})
-		}
+func (pi *podInteractor) Debug(ctx context.Context, podName, namespace, context, image, targetContainer string) (string, error) {
+	gateway := pi.PodRepo[context]
+	if gateway == nil {
+		return "", fmt.Errorf("no gateway found for context: %s", context)
 	}
-	wg.Wait()
-	return podLists, firstErr
+	return gateway.Debug(ctx, podName, namespace, image, targetContainer)
 }
 
-func (pi *podInteractor) GetAllOneContext(ctx context.Context, namespace string, context string) ([]domain.Pod, error) {
-	repo, ok := pi.PodRepo[context]
-	if !ok {
+func (pi *podInteractor) GetPod(ctx context.Context, podName, namespace, context string) (*domain.Pod, error) {
+	gateway := pi.PodRepo[context]
+	if gateway == nil {
 		return nil, fmt.Errorf("no gateway found for context: %s", context)
 	}
-	pods, err := repo.GetAll(ctx, namespace)
-	if err != nil {
-		fmt.Printf("Error fetching pods from context %s: %v\n", context, err)
-		return nil, err
+	return gateway.GetByName(ctx, namespace, podName)
+}
+
+func (pi *podInteractor) Exec(ctx context.Context, podName, namespace, context string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
+	gateway := pi.PodRepo[context]
+	if gateway == nil {
+		return fmt.Errorf("no gateway found for context: %s", context)
 	}
-	return pods, nil
+	return gateway.Exec(ctx, podName, namespace, command, containerName, dryRun, options)
 }
 
-func (pi *podInteractor) PortForward(ctx context.Context, podName, namespace, context string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error) {
+// ExecShell opens the first shell of the list that exists in the container.
+// Every shell is probed with a non interactive command before the session starts,
+// so a missing binary never ends in a half open terminal. started is called with
+// the chosen shell before the session starts, and the shell is returned with the
+// error of the session.
+func (pi *podInteractor) ExecShell(ctx context.Context, podName, namespace, context string, shells []string, containerName string, options remotecommand.StreamOptions, started func(shell string)) (string, error) {
 	gateway := pi.PodRepo[context]
 	if gateway == nil {
-		return nil, nil, fmt.Errorf("no gateway found for context: %s", context)
+		return "", fmt.Errorf("no gateway found for context: %s", context)
+	}
+	for _, shell := range shells {
+		probe := remotecommand.StreamOptions{Stdout: io.Discard, Stderr: io.Discard}
+		err := gateway.Exec(ctx, podName, namespace, []string{shell, "-c", "exit 0"}, containerName, false, probe)
+		if isMissingCommand(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if started != nil {
+			started(shell)
+		}
+		return shell, gateway.Exec(ctx, podName, namespace, []string{shell}, containerName, false, options)
+	}
+	return "", &domain.ShellNotFoundError{Container: containerName, Shells: shells}
+}
+
+// isMissingCommand reports if the exec failed because the binary doesn't exist.
+// The runtimes answer with an exit code (126/127) or with the raw OCI message.
+func isMissingCommand(err error) bool {
+	if err == nil {
+		return false
+	}
+	var exitErr interface{ ExitStatus() int }
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus() == 126 || exitErr.ExitStatus() == 127
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "executable file not found") || strings.Contains(msg, "no such file or directory")
+}
+
+func (pi *podInteractor) GetAll(ctx context.Context, namespace string) (map[string][]domain.Pod, error) {
+	return getAllContexts[domain.Pod](ctx, pi.PodRepo, namespace)
+}
+
+func (pi *podInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.Pod, error) {
+	return getFromManyContext[domain.Pod](ctx, pi.PodRepo, namespaces, contexts)
+}
+
+func (pi *podInteractor) GetAllOneContext(ctx context.Context, namespace string, context string) ([]domain.Pod, error) {
+	repo, ok := pi.PodRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
 	}
-	return gateway.PortForward(namespace, podName, ports, stopChan, readyChan)
+	return repo.GetAll(ctx, namespace)
 }
 
 func (pi *podInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
@@ -1,8 +1,9 @@
 package usecase_test
 
 import (
-	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"k8s.io/client-go/tools/remotecommand"
 	"lazykube/internal/domain"
@@ -25,14 +26,23 @@ func (m *mockPodGateway) GetByLabels(ctx context.Context, namespace string, labe
 func (m *mockPodGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
 	return []byte("yaml"), nil
 }
-func (m *mockPodGateway) Exec(ctx context.Context, podName, namespace, command, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
+func (m *mockPodGateway) Exec(ctx context.Context, podName, namespace string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
 	return nil
 }
 func (m *mockPodGateway) GetLogs(ctx context.Context, podName, namespace, containerName string) (io.ReadCloser, error) {
 	return nil, nil
 }
-func (m *mockPodGateway) PortForward(namespace, podName string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error) {
-	return nil, nil, nil
+func (m *mockPodGateway) Debug(ctx context.Context, podName, namespace, image, targetContainer string) (string, error) {
+	return "debugger", nil
+}
+func (m *mockPodGateway) CopyFrom(ctx context.Context, podName, namespace, containerName, remotePath, localPath string, progress func(int64)) error {
+	return nil
+}
+func (m *mockPodGateway) CopyTo(ctx context.Context, podName, namespace, containerName, localPath, remotePath string, progress func(int64)) error {
+	return nil
+}
+func (m *mockPodGateway) PortForward(ctx context.Context, namespace, podName string, ports []string, out, errOut io.Writer, ready func([]domain.ForwardedPort)) error {
+	return nil
 }
 
 func TestPodInteractor_GetAll_Race(t *testing.T) {
@@ -47,3 +57,120 @@ func TestPodInteractor_GetAll_Race(t *testing.T) {
 		t.Errorf("expected no error, got %v", err)
 	}
 }
+
+type exitError struct{ code int }
+
+func (e exitError) Error() string   { return fmt.Sprintf("command terminated with exit code %d", e.code) }
+func (e exitError) ExitStatus() int { return e.code }
+
+// shellPodGateway only knows the shells of its map, the probe of the shells of
+// failing exits with its code
+type shellPodGateway struct {
+	mockPodGateway
+	shells  map[string]bool
+	failing map[string]int
+	session []string
+}
+
+func (m *shellPodGateway) Exec(ctx context.Context, podName, namespace string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error {
+	if code, ok := m.failing[command[0]]; ok && !options.Tty {
+		return exitError{code: code}
+	}
+	if !m.shells[command[0]] {
+		return exitError{code: 127}
+	}
+	if options.Tty {
+		m.session = command
+	}
+	return nil
+}
+
+func TestPodInteractor_ExecShell_Fallback(t *testing.T) {
+	gateway := &shellPodGateway{shells: map[string]bool{"sh": true}}
+	pi := usecase.NewPodInteractor(map[string]port.PodResourceGateway{"a": gateway})
+
+	started := ""
+	shell, err := pi.ExecShell(context.Background(), "pod", "default", "a", []string{"bash", "sh", "ash"}, "app", remotecommand.StreamOptions{Tty: true}, func(shell string) {
+		started = shell
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if shell != "sh" || started != "sh" {
+		t.Errorf("expected the sh shell to be chosen, got %q started %q", shell, started)
+	}
+	if len(gateway.session) != 1 || gateway.session[0] != "sh" {
+		t.Errorf("expected a sh session, got %v", gateway.session)
+	}
+}
+
+func TestPodInteractor_ExecShell_ProbeFails(t *testing.T) {
+	gateway := &shellPodGateway{shells: map[string]bool{"bash": true, "sh": true}, failing: map[string]int{"bash": 1}}
+	pi := usecase.NewPodInteractor(map[string]port.PodResourceGateway{"a": gateway})
+
+	shell, err := pi.ExecShell(context.Background(), "pod", "default", "a", []string{"bash", "sh"}, "app", remotecommand.StreamOptions{Tty: true}, nil)
+	var exitErr exitError
+	if !errors.As(err, &exitErr) || exitErr.code != 1 {
+		t.Fatalf("expected the exit status 1 of the probe, got %v", err)
+	}
+	if shell != "" || gateway.session != nil {
+		t.Errorf("expected no session, got %q %v", shell, gateway.session)
+	}
+}
+
+func TestPodInteractor_ExecShell_NoShell(t *testing.T) {
+	gateway := &shellPodGateway{shells: map[string]bool{}}
+	pi := usecase.NewPodInteractor(map[string]port.PodResourceGateway{"a": gateway})
+
+	_, err := pi.ExecShell(context.Background(), "pod", "default", "a", []string{"bash", "sh"}, "app", remotecommand.StreamOptions{Tty: true}, func(shell string) {
+		t.Errorf("expected no session, got %s", shell)
+	})
+	var shellErr *domain.ShellNotFoundError
+	if !errors.As(err, &shellErr) {
+		t.Fatalf("expected ShellNotFoundError, got %v", err)
+	}
+}
+
+// failingPodGateway fails to list the pods of the failing namespace
+type failingPodGateway struct {
+	mockPodGateway
+	failing string
+}
+
+func (m *failingPodGateway) GetAll(ctx context.Context, namespace string) ([]domain.Pod, error) {
+	if namespace == m.failing {
+		return nil, errors.New("forbidden")
+	}
+	return []domain.Pod{{Name: "pod-" + namespace}}, nil
+}
+
+func TestPodInteractor_GetFromManyContext_PartialResults(t *testing.T) {
+	pi := usecase.NewPodInteractor(map[string]port.PodResourceGateway{
+		"a": &failingPodGateway{failing: "kube-system"},
+		"b": &failingPodGateway{},
+	})
+
+	podLists, err := pi.GetFromManyContext(context.Background(), []string{"default", "kube-system"}, []string{"a", "b"})
+	var multiErr *domain.MultiContextError
+	if !errors.As(err, &multiErr) {
+		t.Fatalf("expected a MultiContextError, got %v", err)
+	}
+	if len(multiErr.Errors) != 1 || multiErr.Errors[0].Context != "a" || multiErr.Errors[0].Namespace != "kube-system" {
+		t.Errorf("unexpected errors %+v", multiErr.Errors)
+	}
+	if len(podLists["a"]) != 1 || len(podLists["b"]) != 2 {
+		t.Errorf("expected the pods of the namespaces that succeeded, got %v", podLists)
+	}
+}
+
+func TestPodInteractor_Debug(t *testing.T) {
+	pi := usecase.NewPodInteractor(map[string]port.PodResourceGateway{"prod": &mockPodGateway{}})
+
+	name, err := pi.Debug(context.Background(), "web-1", "dev", "prod", "busybox", "app")
+	if err != nil || name != "debugger" {
+		t.Errorf("expected the debug container of the gateway, got %q, %v", name, err)
+	}
+	if _, err := pi.Debug(context.Background(), "web-1", "dev", "staging", "busybox", "app"); err == nil {
+		t.Error("expected an error for an unknown context")
+	}
+}
@@ -0,0 +1,11 @@
+package port
+
+import (
+	"context"
+	"lazykube/internal/domain"
+)
+
+// AccessGateway reviews the permissions of the current user in a context
+type AccessGateway interface {
+	Review(ctx context.Context, namespace, group, resource string, permissions ...domain.Permission) (domain.Access, error)
+}
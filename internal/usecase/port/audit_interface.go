@@ -0,0 +1,9 @@
+package port
+
+import "lazykube/internal/domain"
+
+// AuditJournal is the append-only store of the audit entries
+type AuditJournal interface {
+	Append(entry domain.AuditEntry) error
+	ReadAll() ([]domain.AuditEntry, error)
+}
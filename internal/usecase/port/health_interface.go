@@ -0,0 +1,11 @@
+package port
+
+import (
+	"context"
+	"lazykube/internal/domain"
+)
+
+// HealthGateway checks the connectivity with the API server of a context
+type HealthGateway interface {
+	Check(ctx context.Context) domain.ClusterHealth
+}
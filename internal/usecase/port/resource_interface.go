@@ -1,7 +1,6 @@
 package port
 
 import (
-	"bytes"
 	"context"
 	"io"
 	"lazykube/internal/domain"
@@ -20,9 +19,12 @@ type ResourceGateway[T Resource] interface {
 // PodResourceGateway defines operations specific to Pods, including streaming.
 type PodResourceGateway interface {
 	ResourceGateway[domain.Pod]
-	Exec(ctx context.Context, podName, namespace, command, containerName string, dryRun bool, options remotecommand.StreamOptions) error
+	Exec(ctx context.Context, podName, namespace string, command []string, containerName string, dryRun bool, options remotecommand.StreamOptions) error
 	GetLogs(ctx context.Context, podName, namespace, containerName string) (io.ReadCloser, error)
-	PortForward(namespace, podName string, ports []string, stopChan <-chan struct{}, readyChan chan struct{}) (*bytes.Buffer, *bytes.Buffer, error)
+	Debug(ctx context.Context, podName, namespace, image, targetContainer string) (string, error)
+	CopyFrom(ctx context.Context, podName, namespace, containerName, remotePath, localPath string, progress func(int64)) error
+	CopyTo(ctx context.Context, podName, namespace, containerName, localPath, remotePath string, progress func(int64)) error
+	PortForward(ctx context.Context, namespace, podName string, ports []string, out, errOut io.Writer, ready func([]domain.ForwardedPort)) error
 }
 
 // DeploymentResourceGateway defines operations specific to Deployments.
@@ -31,6 +33,80 @@ type DeploymentResourceGateway interface {
 	GetPods(ctx context.Context, deploymentName, namespace string) ([]domain.Pod, error)
 }
 
+// ServiceResourceGateway defines operations specific to Services. GetEndpoints
+// returns the backends of the service from its EndpointSlices.
+type ServiceResourceGateway interface {
+	ResourceGateway[domain.Service]
+	GetEndpoints(ctx context.Context, namespace, name string) ([]domain.ServiceEndpoint, error)
+}
+
+// SecretResourceGateway defines operations specific to Secrets. GetYaml masks the values.
+type SecretResourceGateway interface {
+	ResourceGateway[domain.Secret]
+	GetData(ctx context.Context, namespace, name string) (map[string][]byte, error)
+}
+
+// ConfigMapResourceGateway defines operations specific to ConfigMaps.
+type ConfigMapResourceGateway interface {
+	ResourceGateway[domain.ConfigMap]
+	PatchValue(ctx context.Context, namespace, name, key, original, value string) error
+	GetReferences(ctx context.Context, namespace, name string) ([]domain.ConfigMapReference, error)
+}
+
+// JobResourceGateway defines operations specific to Jobs.
+type JobResourceGateway interface {
+	ResourceGateway[domain.Job]
+	GetPods(ctx context.Context, jobName, namespace string) ([]domain.Pod, error)
+}
+
+// CronJobResourceGateway defines operations specific to CronJobs.
+type CronJobResourceGateway interface {
+	ResourceGateway[domain.CronJob]
+	SetSuspend(ctx context.Context, namespace, name string, suspend bool) error
+	// Trigger creates a job from the template of the cronjob and returns its name
+	Trigger(ctx context.Context, namespace, name string) (string, error)
+}
+
+// StatefulSetResourceGateway defines operations specific to StatefulSets.
+type StatefulSetResourceGateway interface {
+	ResourceGateway[domain.StatefulSet]
+	GetPods(ctx context.Context, statefulSetName, namespace string) ([]domain.Pod, error)
+	Restart(ctx context.Context, namespace, name string) error
+	Scale(ctx context.Context, namespace, name string, replicas int32) error
+}
+
+// DaemonSetResourceGateway defines operations specific to DaemonSets.
+type DaemonSetResourceGateway interface {
+	ResourceGateway[domain.DaemonSet]
+	GetPods(ctx context.Context, daemonSetName, namespace string) ([]domain.Pod, error)
+	Restart(ctx context.Context, namespace, name string) error
+}
+
+// IngressResourceGateway defines the operations on Ingresses and on Gateway API
+// HTTPRoutes, which are listed empty when the cluster doesn't serve them.
+type IngressResourceGateway interface {
+	ResourceGateway[domain.Ingress]
+}
+
+// PersistentVolumeClaimResourceGateway defines operations specific to PersistentVolumeClaims.
+type PersistentVolumeClaimResourceGateway interface {
+	ResourceGateway[domain.PersistentVolumeClaim]
+}
+
+// PersistentVolumeResourceGateway defines operations specific to PersistentVolumes,
+// which ignore the namespace since they are cluster-scoped.
+type PersistentVolumeResourceGateway interface {
+	ResourceGateway[domain.PersistentVolume]
+}
+
+// HorizontalPodAutoscalerResourceGateway defines operations specific to HorizontalPodAutoscalers.
+type HorizontalPodAutoscalerResourceGateway interface {
+	ResourceGateway[domain.HorizontalPodAutoscaler]
+	SetReplicas(ctx context.Context, namespace, name string, minReplicas, maxReplicas int32) error
+}
+
 type Resource interface {
-	domain.Deployment | domain.Pod | string
+	domain.Deployment | domain.Pod | domain.Service | domain.Secret | domain.ConfigMap | domain.Job | domain.CronJob |
+		domain.StatefulSet | domain.DaemonSet | domain.Ingress | domain.PersistentVolumeClaim | domain.PersistentVolume |
+		domain.HorizontalPodAutoscaler | string
 }
@@ -0,0 +1,427 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// reconnectInterval is the wait between the attempts to find a new pod
+const reconnectInterval = 2 * time.Second
+
+type portForwardInteractor struct {
+	PodRepo        map[string]port.PodResourceGateway
+	DeploymentRepo map[string]port.DeploymentResourceGateway
+	ServiceRepo    map[string]port.ServiceResourceGateway
+
+	mu       sync.Mutex
+	nextID   int
+	sessions map[int]*portForwardSession
+}
+
+// PortForwardInteractor keeps every port-forward session running in background
+type PortForwardInteractor interface {
+	Start(target domain.PortForwardTarget, ports []string, reconnect bool, failed func(err error)) (int, error)
+	Stop(id int) error
+	Remove(id int) error
+	Prune() int
+	List() []domain.PortForward
+}
+
+// NewPortForwardInteractor return a new struct with portForwardInteractor
+func NewPortForwardInteractor(
+	podRepo map[string]port.PodResourceGateway,
+	deploymentRepo map[string]port.DeploymentResourceGateway,
+	serviceRepo map[string]port.ServiceResourceGateway,
+) PortForwardInteractor {
+	return &portForwardInteractor{
+		PodRepo:        podRepo,
+		DeploymentRepo: deploymentRepo,
+		ServiceRepo:    serviceRepo,
+		sessions:       map[int]*portForwardSession{},
+	}
+}
+
+// portForwardSession is a running port-forward, its state is guarded by mu
+type portForwardSession struct {
+	mu     sync.Mutex
+	state  domain.PortForward
+	out    *lineWriter
+	errOut *lineWriter
+	cancel context.CancelFunc
+	failed func(err error)
+}
+
+func (s *portForwardSession) update(fn func(state *domain.PortForward)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(&s.state)
+}
+
+func (s *portForwardSession) snapshot() domain.PortForward {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state
+}
+
+// fail moves the session to the failed status and reports the error to the
+// failed hook of Start
+func (s *portForwardSession) fail(err error) {
+	s.update(func(state *domain.PortForward) { state.Status = domain.PortForwardFailed })
+	if s.failed != nil {
+		s.failed(err)
+	}
+}
+
+// ended reports if the session doesn't run anymore
+func (s *portForwardSession) ended() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.Status == domain.PortForwardStopped || s.state.Status == domain.PortForwardFailed
+}
+
+// Start begins a port-forward session in background and returns its id.
+// With reconnect a session of a deployment or a service moves to another pod
+// of it when the connection with the pod is lost. failed, when not nil, is
+// called from the session goroutine when the session moves to the failed status.
+func (pfi *portForwardInteractor) Start(target domain.PortForwardTarget, ports []string, reconnect bool, failed func(err error)) (int, error) {
+	gateway := pfi.PodRepo[target.Context]
+	if gateway == nil {
+		return 0, fmt.Errorf("no gateway found for context: %s", target.Context)
+	}
+	if len(ports) == 0 {
+		return 0, errors.New("no ports to forward")
+	}
+	if target.Pod == "" && target.Deployment == "" && target.Service == "" {
+		return 0, errors.New("no pod, deployment or service to forward")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pfi.mu.Lock()
+	pfi.nextID++
+	session := &portForwardSession{
+		state: domain.PortForward{
+			ID:        pfi.nextID,
+			Target:    target,
+			Ports:     ports,
+			Status:    domain.PortForwardStarting,
+			Reconnect: reconnect && (target.Deployment != "" || target.Service != ""),
+			StartedAt: time.Now(),
+		},
+		cancel: cancel,
+		failed: failed,
+	}
+	// Only the count of the connections and the last error are kept, the
+	// output of a long session would grow without limit
+	session.out = &lineWriter{line: func(line string) {
+		if strings.Contains(line, "Handling connection") {
+			session.update(func(state *domain.PortForward) { state.Connections++ })
+		}
+	}}
+	session.errOut = &lineWriter{line: func(line string) {
+		if line = strings.TrimSpace(line); line != "" {
+			session.update(func(state *domain.PortForward) { state.LastError = line })
+		}
+	}}
+	pfi.sessions[session.state.ID] = session
+	pfi.mu.Unlock()
+
+	go pfi.run(ctx, gateway, session)
+	return session.state.ID, nil
+}
+
+func (pfi *portForwardInteractor) run(ctx context.Context, gateway port.PodResourceGateway, session *portForwardSession) {
+	state := session.snapshot()
+	target := state.Target
+	podPorts := state.Ports
+	var err error
+	if target.Service != "" || target.Pod == "" {
+		target.Pod, podPorts, err = pfi.resolvePod(ctx, target, state.Ports)
+	} else if hasNamedPort(state.Ports) {
+		podPorts, err = pfi.podPorts(ctx, gateway, target, state.Ports)
+	}
+	if err != nil {
+		fmt.Fprintln(session.errOut, err.Error())
+		session.fail(err)
+		return
+	}
+
+	for {
+		// The gateway returns after the ready callback ends, so wasActive needs no lock.
+		// It is reset for every pod, a replacement that never forwards fails the session.
+		wasActive := false
+		err := gateway.PortForward(ctx, target.Namespace, target.Pod, podPorts, session.out, session.errOut, func(bound []domain.ForwardedPort) {
+			wasActive = true
+			session.update(func(state *domain.PortForward) {
+				state.Status = domain.PortForwardActive
+				state.Target = target
+				state.Bound = bound
+			})
+		})
+		if ctx.Err() != nil {
+			session.update(func(state *domain.PortForward) { state.Status = domain.PortForwardStopped })
+			return
+		}
+		if err != nil {
+			slog.Warn("port-forward lost", "id", state.ID, "context", target.Context, "namespace", target.Namespace, "pod", target.Pod, "error", err)
+			fmt.Fprintln(session.errOut, err.Error())
+		}
+		// A session that never was active has a problem that a new pod doesn't fix
+		if !state.Reconnect || !wasActive {
+			if err == nil {
+				err = domain.ErrPortForwardLost
+			}
+			session.fail(err)
+			return
+		}
+
+		session.update(func(state *domain.PortForward) {
+			state.Status = domain.PortForwardReconnecting
+			state.Bound = nil
+		})
+		pod, ports, err := pfi.waitReplacementPod(ctx, target, state.Ports)
+		if err != nil {
+			session.update(func(state *domain.PortForward) { state.Status = domain.PortForwardStopped })
+			return
+		}
+		target.Pod, podPorts = pod, ports
+		session.update(func(state *domain.PortForward) { state.Restarts++ })
+	}
+}
+
+// waitReplacementPod polls the deployment or the service of the target until
+// it has a running pod, and returns it with the ports of the pod to forward
+func (pfi *portForwardInteractor) waitReplacementPod(ctx context.Context, target domain.PortForwardTarget, ports []string) (string, []string, error) {
+	for {
+		if pod, podPorts, err := pfi.resolvePod(ctx, target, ports); err == nil {
+			return pod, podPorts, nil
+		}
+		select {
+		case <-ctx.Done():
+			return "", nil, ctx.Err()
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+// resolvePod chooses a running pod of the deployment or the service of the target
+func (pfi *portForwardInteractor) resolvePod(ctx context.Context, target domain.PortForwardTarget, ports []string) (string, []string, error) {
+	if target.Service != "" {
+		return pfi.resolveService(ctx, target, ports)
+	}
+	deployGateway := pfi.DeploymentRepo[target.Context]
+	if deployGateway == nil {
+		return "", nil, fmt.Errorf("no gateway found for context: %s", target.Context)
+	}
+	pods, err := deployGateway.GetPods(ctx, target.Deployment, target.Namespace)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, pod := range pods {
+		if pod.State == "Running" {
+			podPorts, err := mapPodPorts(pod, ports)
+			return pod.Name, podPorts, err
+		}
+	}
+	return "", nil, fmt.Errorf("deployment %s has no running pods", target.Deployment)
+}
+
+// resolveService chooses a ready pod of the service and maps the ports of the
+// service to the target ports of the pod
+func (pfi *portForwardInteractor) resolveService(ctx context.Context, target domain.PortForwardTarget, ports []string) (string, []string, error) {
+	serviceGateway := pfi.ServiceRepo[target.Context]
+	podGateway := pfi.PodRepo[target.Context]
+	if serviceGateway == nil || podGateway == nil {
+		return "", nil, fmt.Errorf("no gateway found for context: %s", target.Context)
+	}
+	service, err := serviceGateway.GetByName(ctx, target.Namespace, target.Service)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(service.Selector) == 0 {
+		return "", nil, fmt.Errorf("service %s has no selector", service.Name)
+	}
+	pods, err := podGateway.GetByLabels(ctx, target.Namespace, service.Selector)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, pod := range pods {
+		if pod.Ready && pod.State == "Running" {
+			podPorts, err := mapServicePorts(*service, pod, ports)
+			return pod.Name, podPorts, err
+		}
+	}
+	return "", nil, fmt.Errorf("service %s has no ready pods", service.Name)
+}
+
+// podPorts reads the spec of the pod of the target to resolve the port names of the mappings
+func (pfi *portForwardInteractor) podPorts(ctx context.Context, gateway port.PodResourceGateway, target domain.PortForwardTarget, ports []string) ([]string, error) {
+	pod, err := gateway.GetByName(ctx, target.Namespace, target.Pod)
+	if err != nil {
+		return nil, err
+	}
+	return mapPodPorts(*pod, ports)
+}
+
+// hasNamedPort reports if a mapping forwards to a port name instead of a number
+func hasNamedPort(ports []string) bool {
+	for _, mapping := range ports {
+		_, remote, found := strings.Cut(mapping, ":")
+		if !found {
+			remote = mapping
+		}
+		if _, err := strconv.Atoi(remote); err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// mapPodPorts converts the "local:name" mappings to "local:number" with the
+// container ports of the pod, like kubectl. Without local the number of the
+// container port is used, an empty local (":http") asks for a random one.
+func mapPodPorts(pod domain.Pod, ports []string) ([]string, error) {
+	mapped := []string{}
+	for _, mapping := range ports {
+		local, remote, found := strings.Cut(mapping, ":")
+		if !found {
+			local, remote = "", mapping
+		}
+		if _, err := strconv.Atoi(remote); err == nil {
+			mapped = append(mapped, mapping)
+			continue
+		}
+		number, ok := pod.NamedPort(remote)
+		if !ok {
+			return nil, fmt.Errorf("pod %s has no port named %s", pod.Name, remote)
+		}
+		if !found {
+			local = strconv.Itoa(int(number))
+		}
+		mapped = append(mapped, local+":"+strconv.Itoa(int(number)))
+	}
+	return mapped, nil
+}
+
+// mapServicePorts converts "local:servicePort" mappings, where the service port
+// is a number or a name, to "local:podPort" mappings. Without local the port of
+// the service is used, and an empty local (":http") asks for a random one.
+func mapServicePorts(service domain.Service, pod domain.Pod, ports []string) ([]string, error) {
+	mapped := []string{}
+	for _, mapping := range ports {
+		local, remote, found := strings.Cut(mapping, ":")
+		if !found {
+			local, remote = "", mapping
+		}
+
+		var servicePort *domain.ServicePort
+		for i, candidate := range service.Ports {
+			if candidate.Name == remote || strconv.Itoa(int(candidate.Port)) == remote {
+				servicePort = &service.Ports[i]
+				break
+			}
+		}
+		if servicePort == nil {
+			return nil, fmt.Errorf("service %s has no port %s", service.Name, remote)
+		}
+		if !found {
+			local = strconv.Itoa(int(servicePort.Port))
+		}
+
+		podPort := servicePort.TargetPort
+		if podPort == "" || podPort == "0" {
+			podPort = strconv.Itoa(int(servicePort.Port))
+		}
+		if _, err := strconv.Atoi(podPort); err != nil {
+			number, ok := pod.NamedPort(podPort)
+			if !ok {
+				return nil, fmt.Errorf("pod %s has no port named %s", pod.Name, podPort)
+			}
+			podPort = strconv.Itoa(int(number))
+		}
+		mapped = append(mapped, local+":"+podPort)
+	}
+	return mapped, nil
+}
+
+// Stop ends the session, it stays in the list with the stopped status
+func (pfi *portForwardInteractor) Stop(id int) error {
+	pfi.mu.Lock()
+	session, ok := pfi.sessions[id]
+	pfi.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("no port-forward found with id: %d", id)
+	}
+	session.cancel()
+	return nil
+}
+
+// Remove deletes a stopped or failed session from the list
+func (pfi *portForwardInteractor) Remove(id int) error {
+	pfi.mu.Lock()
+	defer pfi.mu.Unlock()
+	session, ok := pfi.sessions[id]
+	if !ok {
+		return fmt.Errorf("no port-forward found with id: %d", id)
+	}
+	if !session.ended() {
+		return fmt.Errorf("port-forward %d is still running, stop it first", id)
+	}
+	delete(pfi.sessions, id)
+	return nil
+}
+
+// Prune deletes every stopped or failed session and returns how many were deleted
+func (pfi *portForwardInteractor) Prune() int {
+	pfi.mu.Lock()
+	defer pfi.mu.Unlock()
+	pruned := 0
+	for id, session := range pfi.sessions {
+		if session.ended() {
+			delete(pfi.sessions, id)
+			pruned++
+		}
+	}
+	return pruned
+}
+
+// List returns the state of every session sorted by id
+func (pfi *portForwardInteractor) List() []domain.PortForward {
+	pfi.mu.Lock()
+	defer pfi.mu.Unlock()
+	forwards := make([]domain.PortForward, 0, len(pfi.sessions))
+	for _, session := range pfi.sessions {
+		forwards = append(forwards, session.snapshot())
+	}
+	sort.Slice(forwards, func(i, j int) bool { return forwards[i].ID < forwards[j].ID })
+	return forwards
+}
+
+// lineWriter passes every complete line written by the forwarder to line
+type lineWriter struct {
+	mu      sync.Mutex
+	partial bytes.Buffer
+	line    func(string)
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.partial.Write(p)
+	for {
+		i := bytes.IndexByte(w.partial.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		w.line(string(w.partial.Next(i + 1)))
+	}
+}
@@ -0,0 +1,368 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// forwardPodGateway loses the connection with the pods of the lost map, the
+// pods of the broken map fail before forwarding
+type forwardPodGateway struct {
+	mockPodGateway
+	lost   map[string]bool
+	broken map[string]bool
+	pods   []domain.Pod
+	mu     sync.Mutex
+	ports  []string
+}
+
+func (m *forwardPodGateway) GetByLabels(ctx context.Context, namespace string, label map[string]string) ([]domain.Pod, error) {
+	return m.pods, nil
+}
+
+func (m *forwardPodGateway) PortForward(ctx context.Context, namespace, podName string, ports []string, out, errOut io.Writer, ready func([]domain.ForwardedPort)) error {
+	m.mu.Lock()
+	m.ports = ports
+	m.mu.Unlock()
+	if m.broken[podName] {
+		return errors.New("unable to listen on port 80")
+	}
+	ready([]domain.ForwardedPort{{Local: 8080, Remote: 80}})
+	fmt.Fprintln(out, "Handling connection for 8080")
+	fmt.Fprint(out, "Handling conn")
+	fmt.Fprintln(out, "ection for 8080")
+	if m.lost[podName] {
+		return domain.ErrPortForwardLost
+	}
+	<-ctx.Done()
+	return nil
+}
+
+type mockDeploymentGateway struct {
+	pods []domain.Pod
+}
+
+func (m *mockDeploymentGateway) GetAll(ctx context.Context, namespace string) ([]domain.Deployment, error) {
+	return nil, nil
+}
+func (m *mockDeploymentGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Deployment, error) {
+	return &domain.Deployment{Name: name}, nil
+}
+func (m *mockDeploymentGateway) GetByLabels(ctx context.Context, namespace string, label map[string]string) ([]domain.Deployment, error) {
+	return nil, nil
+}
+func (m *mockDeploymentGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	return []byte("yaml"), nil
+}
+func (m *mockDeploymentGateway) GetPods(ctx context.Context, deploymentName, namespace string) ([]domain.Pod, error) {
+	return m.pods, nil
+}
+
+type mockServiceGateway struct {
+	service   domain.Service
+	endpoints []domain.ServiceEndpoint
+}
+
+func (m *mockServiceGateway) GetAll(ctx context.Context, namespace string) ([]domain.Service, error) {
+	return []domain.Service{m.service}, nil
+}
+func (m *mockServiceGateway) GetByName(ctx context.Context, namespace string, name string) (*domain.Service, error) {
+	return &m.service, nil
+}
+func (m *mockServiceGateway) GetByLabels(ctx context.Context, namespace string, label map[string]string) ([]domain.Service, error) {
+	return nil, nil
+}
+func (m *mockServiceGateway) GetYaml(ctx context.Context, namespace string, name string) ([]byte, error) {
+	return []byte("yaml"), nil
+}
+func (m *mockServiceGateway) GetEndpoints(ctx context.Context, namespace, name string) ([]domain.ServiceEndpoint, error) {
+	return m.endpoints, nil
+}
+
+func waitStatus(t *testing.T, pfi usecase.PortForwardInteractor, status string) domain.PortForward {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		forwards := pfi.List()
+		if len(forwards) == 1 && forwards[0].Status == status {
+			return forwards[0]
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("the port-forward never reached the %s status: %+v", status, pfi.List())
+	return domain.PortForward{}
+}
+
+func TestPortForwardInteractor_ReconnectsToNewPod(t *testing.T) {
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": &forwardPodGateway{lost: map[string]bool{"web-old": true}}},
+		map[string]port.DeploymentResourceGateway{"a": &mockDeploymentGateway{pods: []domain.Pod{{Name: "web-new", State: "Running"}}}},
+		map[string]port.ServiceResourceGateway{},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Pod: "web-old", Deployment: "web"}
+	id, err := pfi.Start(target, []string{"8080:80"}, true, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	forward := waitStatus(t, pfi, domain.PortForwardActive)
+	for forward.Target.Pod != "web-new" {
+		forward = waitStatus(t, pfi, domain.PortForwardActive)
+	}
+	if forward.Restarts != 1 {
+		t.Errorf("expected 1 restart, got %d", forward.Restarts)
+	}
+
+	if err := pfi.Stop(id); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	waitStatus(t, pfi, domain.PortForwardStopped)
+}
+
+func TestPortForwardInteractor_FailsWhenReplacementNeverForwards(t *testing.T) {
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": &forwardPodGateway{
+			lost:   map[string]bool{"web-old": true},
+			broken: map[string]bool{"web-new": true},
+		}},
+		map[string]port.DeploymentResourceGateway{"a": &mockDeploymentGateway{pods: []domain.Pod{{Name: "web-new", State: "Running"}}}},
+		map[string]port.ServiceResourceGateway{},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Pod: "web-old", Deployment: "web"}
+	if _, err := pfi.Start(target, []string{"8080:80"}, true, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	forward := waitStatus(t, pfi, domain.PortForwardFailed)
+	if forward.Restarts != 1 || forward.LastError != "unable to listen on port 80" {
+		t.Errorf("expected the failure of the replacement, got %+v", forward)
+	}
+}
+
+func TestPortForwardInteractor_FailsWithoutReconnect(t *testing.T) {
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": &forwardPodGateway{lost: map[string]bool{"web-old": true}}},
+		map[string]port.DeploymentResourceGateway{},
+		map[string]port.ServiceResourceGateway{},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Pod: "web-old"}
+	failed := make(chan error, 1)
+	if _, err := pfi.Start(target, []string{"8080:80"}, true, func(err error) { failed <- err }); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	forward := waitStatus(t, pfi, domain.PortForwardFailed)
+	if forward.LastError == "" {
+		t.Errorf("expected the error of the session")
+	}
+	select {
+	case err := <-failed:
+		if !errors.Is(err, domain.ErrPortForwardLost) {
+			t.Errorf("expected the lost error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("expected the failed hook to be called")
+	}
+}
+
+func TestPortForwardInteractor_RemoveEndedSessions(t *testing.T) {
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": &forwardPodGateway{}},
+		map[string]port.DeploymentResourceGateway{},
+		map[string]port.ServiceResourceGateway{},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Pod: "web"}
+	id, err := pfi.Start(target, []string{"8080:80"}, false, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	forward := waitStatus(t, pfi, domain.PortForwardActive)
+	for forward.Connections < 2 {
+		forward = waitStatus(t, pfi, domain.PortForwardActive)
+	}
+	if forward.Connections != 2 {
+		t.Errorf("expected 2 connections, got %d", forward.Connections)
+	}
+	if err := pfi.Remove(id); err == nil {
+		t.Errorf("expected an error removing a running session")
+	}
+
+	if err := pfi.Stop(id); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	waitStatus(t, pfi, domain.PortForwardStopped)
+	if err := pfi.Remove(id); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if forwards := pfi.List(); len(forwards) != 0 {
+		t.Errorf("expected no sessions, got %+v", forwards)
+	}
+	if err := pfi.Remove(id); err == nil {
+		t.Errorf("expected an error removing an unknown session")
+	}
+}
+
+func TestPortForwardInteractor_Prune(t *testing.T) {
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": &forwardPodGateway{lost: map[string]bool{"web-old": true}}},
+		map[string]port.DeploymentResourceGateway{},
+		map[string]port.ServiceResourceGateway{},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Pod: "web-old"}
+	if _, err := pfi.Start(target, []string{"8080:80"}, false, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	waitStatus(t, pfi, domain.PortForwardFailed)
+	target.Pod = "web"
+	if _, err := pfi.Start(target, []string{"8081:80"}, false, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if pruned := pfi.Prune(); pruned != 1 {
+		t.Errorf("expected 1 pruned session, got %d", pruned)
+	}
+	forwards := pfi.List()
+	if len(forwards) != 1 || forwards[0].Target.Pod != "web" {
+		t.Errorf("expected only the running session, got %+v", forwards)
+	}
+}
+
+func TestPortForwardInteractor_ServiceNamedPorts(t *testing.T) {
+	pods := &forwardPodGateway{pods: []domain.Pod{
+		{Name: "web-pending", State: "Pending"},
+		{Name: "web-ready", State: "Running", Ready: true, Containers: []domain.Container{
+			{Name: "web", Ports: []domain.ContainerPort{{Name: "http", ContainerPort: 8080}}},
+		}},
+	}}
+	service := domain.Service{
+		Name:     "web",
+		Selector: map[string]string{"app": "web"},
+		Ports: []domain.ServicePort{
+			{Name: "http", Port: 80, TargetPort: "http"},
+			{Name: "metrics", Port: 9090, TargetPort: "9100"},
+		},
+	}
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": pods},
+		map[string]port.DeploymentResourceGateway{},
+		map[string]port.ServiceResourceGateway{"a": &mockServiceGateway{service: service}},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Service: "web"}
+	if _, err := pfi.Start(target, []string{"8000:http", "9090"}, true, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	forward := waitStatus(t, pfi, domain.PortForwardActive)
+	if forward.Target.Pod != "web-ready" {
+		t.Errorf("expected the ready pod, got %s", forward.Target.Pod)
+	}
+	if !forward.Reconnect {
+		t.Errorf("expected reconnect for a service")
+	}
+
+	pods.mu.Lock()
+	defer pods.mu.Unlock()
+	if expected := []string{"8000:8080", "9090:9100"}; !reflect.DeepEqual(pods.ports, expected) {
+		t.Errorf("expected ports %v, got %v", expected, pods.ports)
+	}
+}
+
+func TestPortForwardInteractor_ServiceUnknownPort(t *testing.T) {
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": &forwardPodGateway{pods: []domain.Pod{{Name: "web", State: "Running", Ready: true}}}},
+		map[string]port.DeploymentResourceGateway{},
+		map[string]port.ServiceResourceGateway{"a": &mockServiceGateway{service: domain.Service{
+			Name:     "web",
+			Selector: map[string]string{"app": "web"},
+			Ports:    []domain.ServicePort{{Name: "http", Port: 80}},
+		}}},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Service: "web"}
+	if _, err := pfi.Start(target, []string{"grpc"}, false, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	forward := waitStatus(t, pfi, domain.PortForwardFailed)
+	if forward.LastError == "" {
+		t.Errorf("expected the error of the session")
+	}
+}
+
+func TestPortForwardInteractor_DeploymentWithoutPod(t *testing.T) {
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": &forwardPodGateway{}},
+		map[string]port.DeploymentResourceGateway{"a": &mockDeploymentGateway{pods: []domain.Pod{
+			{Name: "web-pending", State: "Pending"},
+			{Name: "web-running", State: "Running"},
+		}}},
+		map[string]port.ServiceResourceGateway{},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Deployment: "web"}
+	if _, err := pfi.Start(target, []string{"8080:80"}, false, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	forward := waitStatus(t, pfi, domain.PortForwardActive)
+	if forward.Target.Pod != "web-running" {
+		t.Errorf("expected the running pod, got %s", forward.Target.Pod)
+	}
+
+	if _, err := pfi.Start(domain.PortForwardTarget{Context: "a"}, []string{"8080:80"}, false, nil); err == nil {
+		t.Errorf("expected an error without pod, deployment or service")
+	}
+}
+
+func TestPortForwardInteractor_DeploymentNamedPorts(t *testing.T) {
+	pods := &forwardPodGateway{}
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": pods},
+		map[string]port.DeploymentResourceGateway{"a": &mockDeploymentGateway{pods: []domain.Pod{
+			{Name: "db-0", State: "Running", Containers: []domain.Container{
+				{Name: "db", Ports: []domain.ContainerPort{{Name: "postgres", ContainerPort: 5432}}},
+				{Name: "exporter", Ports: []domain.ContainerPort{{Name: "metrics", ContainerPort: 9187}}},
+			}},
+		}}},
+		map[string]port.ServiceResourceGateway{},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Deployment: "db"}
+	if _, err := pfi.Start(target, []string{"15432:postgres", "metrics", ":9187"}, true, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	waitStatus(t, pfi, domain.PortForwardActive)
+
+	pods.mu.Lock()
+	defer pods.mu.Unlock()
+	if expected := []string{"15432:5432", "9187:9187", ":9187"}; !reflect.DeepEqual(pods.ports, expected) {
+		t.Errorf("expected ports %v, got %v", expected, pods.ports)
+	}
+}
+
+func TestPortForwardInteractor_UnknownPortName(t *testing.T) {
+	pfi := usecase.NewPortForwardInteractor(
+		map[string]port.PodResourceGateway{"a": &forwardPodGateway{}},
+		map[string]port.DeploymentResourceGateway{"a": &mockDeploymentGateway{pods: []domain.Pod{{Name: "db-0", State: "Running"}}}},
+		map[string]port.ServiceResourceGateway{},
+	)
+
+	target := domain.PortForwardTarget{Context: "a", Namespace: "default", Deployment: "db"}
+	if _, err := pfi.Start(target, []string{"5432:postgres"}, false, nil); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	forward := waitStatus(t, pfi, domain.PortForwardFailed)
+	if forward.LastError != "pod db-0 has no port named postgres" {
+		t.Errorf("expected the unknown port name, got %q", forward.LastError)
+	}
+}
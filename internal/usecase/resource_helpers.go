@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"log/slog"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// getFromManyContext lists the resources of every namespace in every context in parallel.
+// The results of the contexts that succeed are returned with a *domain.MultiContextError
+// describing the ones that failed.
+func getFromManyContext[T port.Resource, G port.ResourceGateway[T]](ctx context.Context, repos map[string]G, namespaces []string, contexts []string) (map[string][]T, error) {
+	var (
+		lists  = make(map[string][]T)
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		failed []domain.ContextError
+	)
+
+	for _, clusterCtx := range contexts {
+		repo, ok := repos[clusterCtx]
+		if !ok {
+			continue
+		}
+		for _, ns := range listedNamespaces(namespaces) {
+			wg.Go(func() {
+				items, err := repo.GetAll(ctx, ns)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					slog.Warn("listing failed", "context", clusterCtx, "namespace", ns, "error", err)
+					failed = append(failed, domain.ContextError{Context: clusterCtx, Namespace: ns, Err: err})
+					return
+				}
+				lists[clusterCtx] = append(lists[clusterCtx], items...)
+			})
+		}
+	}
+	wg.Wait()
+	if len(failed) > 0 {
+		sort.Slice(failed, func(i, j int) bool {
+			if failed[i].Context != failed[j].Context {
+				return failed[i].Context < failed[j].Context
+			}
+			return failed[i].Namespace < failed[j].Namespace
+		})
+		return lists, &domain.MultiContextError{Errors: failed}
+	}
+	return lists, nil
+}
+
+// getAllContexts lists the resources of the namespace in every context
+func getAllContexts[T port.Resource, G port.ResourceGateway[T]](ctx context.Context, repos map[string]G, namespace string) (map[string][]T, error) {
+	return getFromManyContext[T](ctx, repos, []string{namespace}, slices.Sorted(maps.Keys(repos)))
+}
+
+// listedNamespaces returns the namespaces to query, all namespaces make the
+// others redundant
+func listedNamespaces(namespaces []string) []string {
+	if slices.Contains(namespaces, domain.AllNamespaces) {
+		return []string{domain.AllNamespaces}
+	}
+	return namespaces
+}
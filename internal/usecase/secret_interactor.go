@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"sigs.k8s.io/yaml"
+)
+
+type secretInteractor struct {
+	SecretRepo map[string]port.SecretResourceGateway
+}
+
+// SecretInteractor is an interface for connect to secret interactor
+type SecretInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.Secret, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	GetSecret(ctx context.Context, secretName, namespace, context string) (*domain.Secret, error)
+	GetDecoded(ctx context.Context, secretName, namespace, context string) ([]byte, error)
+	GetValue(ctx context.Context, secretName, namespace, context, key string) ([]byte, error)
+}
+
+// NewSecretInteractor return a new struct with secretInteractor
+func NewSecretInteractor(secretRepo map[string]port.SecretResourceGateway) SecretInteractor {
+	return &secretInteractor{
+		SecretRepo: secretRepo,
+	}
+}
+
+func (si *secretInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.Secret, error) {
+	return getFromManyContext[domain.Secret](ctx, si.SecretRepo, namespaces, contexts)
+}
+
+func (si *secretInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, ok := si.SecretRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+func (si *secretInteractor) GetSecret(ctx context.Context, secretName, namespace, context string) (*domain.Secret, error) {
+	gateway, ok := si.SecretRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetByName(ctx, namespace, secretName)
+}
+
+// GetDecoded returns the values of the secret as yaml. The PEM certificates are
+// shown by their subject and expiry, and the binary values by their size.
+func (si *secretInteractor) GetDecoded(ctx context.Context, secretName, namespace, context string) ([]byte, error) {
+	gateway, ok := si.SecretRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	data, err := gateway.GetData(ctx, namespace, secretName)
+	if err != nil {
+		return nil, err
+	}
+	decoded := map[string]string{}
+	for key, value := range data {
+		decoded[key] = describeSecretValue(value, time.Now())
+	}
+	out, err := yaml.Marshal(map[string]any{"data": decoded})
+	if err != nil {
+		return nil, err
+	}
+	header := fmt.Sprintf("# secret %s/%s decoded\n", namespace, secretName)
+	return append([]byte(header), out...), nil
+}
+
+func (si *secretInteractor) GetValue(ctx context.Context, secretName, namespace, context, key string) ([]byte, error) {
+	gateway, ok := si.SecretRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	data, err := gateway.GetData(ctx, namespace, secretName)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := data[key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s has no key %s", secretName, key)
+	}
+	return value, nil
+}
+
+// describeSecretValue returns the value as text, the certificates of a PEM
+// bundle one by line and the binary values by their size
+func describeSecretValue(value []byte, now time.Time) string {
+	if certificates := describeCertificates(value, now); certificates != "" {
+		return certificates
+	}
+	if !utf8.Valid(value) {
+		return fmt.Sprintf("<binary, %d bytes>", len(value))
+	}
+	return string(value)
+}
+
+func describeCertificates(value []byte, now time.Time) string {
+	lines := []string{}
+	for rest := value; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			lines = append(lines, fmt.Sprintf("<%s>", strings.ToLower(block.Type)))
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			lines = append(lines, fmt.Sprintf("certificate: invalid (%v)", err))
+			continue
+		}
+		expiry := certificate.NotAfter.UTC().Format(time.RFC3339)
+		if now.After(certificate.NotAfter) {
+			expiry += " (expired)"
+		} else {
+			expiry += fmt.Sprintf(" (in %dd)", int(certificate.NotAfter.Sub(now).Hours()/24))
+		}
+		lines = append(lines, fmt.Sprintf("certificate: subject=%s expires=%s", certificate.Subject, expiry))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+type mockSecretGateway struct {
+	port.ResourceGateway[domain.Secret]
+	data map[string][]byte
+}
+
+func (m *mockSecretGateway) GetData(ctx context.Context, namespace, name string) (map[string][]byte, error) {
+	return m.data, nil
+}
+
+func testCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestSecretInteractor_GetDecoded(t *testing.T) {
+	si := usecase.NewSecretInteractor(map[string]port.SecretResourceGateway{
+		"prod": &mockSecretGateway{data: map[string][]byte{
+			"password": []byte("hunter2"),
+			"tls.crt":  testCertificate(t, time.Now().Add(-time.Hour)),
+			"blob":     {0xff, 0xfe, 0x00},
+		}},
+	})
+
+	out, err := si.GetDecoded(context.Background(), "web", "dev", "prod")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	text := string(out)
+	for _, expected := range []string{"password: hunter2", "subject=CN=example.com", "(expired)", "<binary, 3 bytes>"} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("expected %q in:\n%s", expected, text)
+		}
+	}
+}
+
+func TestSecretInteractor_GetValue(t *testing.T) {
+	si := usecase.NewSecretInteractor(map[string]port.SecretResourceGateway{
+		"prod": &mockSecretGateway{data: map[string][]byte{"password": []byte("hunter2")}},
+	})
+
+	value, err := si.GetValue(context.Background(), "web", "dev", "prod", "password")
+	if err != nil || string(value) != "hunter2" {
+		t.Fatalf("expected the raw value, got %q %v", value, err)
+	}
+	if _, err := si.GetValue(context.Background(), "web", "dev", "prod", "token"); err == nil {
+		t.Error("expected an error for a missing key")
+	}
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+	"sort"
+)
+
+type serviceInteractor struct {
+	ServiceRepo map[string]port.ServiceResourceGateway
+}
+
+// ServiceInteractor is an interface for connect to service interactor
+type ServiceInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.Service, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	GetEndpoints(ctx context.Context, name, namespace, context string) ([]domain.ServiceEndpoint, error)
+}
+
+// NewServiceInteractor return a new struct with serviceInteractor
+func NewServiceInteractor(serviceRepo map[string]port.ServiceResourceGateway) ServiceInteractor {
+	return &serviceInteractor{
+		ServiceRepo: serviceRepo,
+	}
+}
+
+func (si *serviceInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.Service, error) {
+	return getFromManyContext[domain.Service](ctx, si.ServiceRepo, namespaces, contexts)
+}
+
+func (si *serviceInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, ok := si.ServiceRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+// GetEndpoints returns the backends of the service, the ones not ready first
+// since they are why a service fails
+func (si *serviceInteractor) GetEndpoints(ctx context.Context, name, namespace, context string) ([]domain.ServiceEndpoint, error) {
+	gateway, ok := si.ServiceRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	endpoints, err := gateway.GetEndpoints(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].Ready != endpoints[j].Ready {
+			return !endpoints[i].Ready
+		}
+		return endpoints[i].Pod < endpoints[j].Pod
+	})
+	return endpoints, nil
+}
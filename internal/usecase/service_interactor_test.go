@@ -0,0 +1,35 @@
+package usecase_test
+
+import (
+	"context"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase"
+	"lazykube/internal/usecase/port"
+	"testing"
+)
+
+func TestServiceInteractor_GetEndpointsNotReadyFirst(t *testing.T) {
+	si := usecase.NewServiceInteractor(map[string]port.ServiceResourceGateway{
+		"prod": &mockServiceGateway{endpoints: []domain.ServiceEndpoint{
+			{Pod: "web-b", Ready: true},
+			{Pod: "web-c", Ready: false},
+			{Pod: "web-a", Ready: true},
+		}},
+	})
+
+	endpoints, err := si.GetEndpoints(context.Background(), "web", "dev", "prod")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := []string{}
+	for _, endpoint := range endpoints {
+		got = append(got, endpoint.Pod)
+	}
+	if len(got) != 3 || got[0] != "web-c" || got[1] != "web-a" || got[2] != "web-b" {
+		t.Errorf("unexpected order %v", got)
+	}
+
+	if _, err := si.GetEndpoints(context.Background(), "web", "dev", "staging"); err == nil {
+		t.Error("expected an error for an unknown context")
+	}
+}
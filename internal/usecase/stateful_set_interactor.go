@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"lazykube/internal/domain"
+	"lazykube/internal/usecase/port"
+)
+
+type statefulSetInteractor struct {
+	StatefulSetRepo map[string]port.StatefulSetResourceGateway
+}
+
+// StatefulSetInteractor is an interface for connect to statefulset interactor
+type StatefulSetInteractor interface {
+	GetFromManyContext(context.Context, []string, []string) (map[string][]domain.StatefulSet, error)
+	GetYaml(context.Context, string, string, string) ([]byte, error)
+	GetPods(ctx context.Context, statefulSetName, namespace, context string) ([]domain.Pod, error)
+	Restart(ctx context.Context, statefulSetName, namespace, context string) error
+	Scale(ctx context.Context, statefulSetName, namespace, context string, replicas int32) error
+}
+
+// NewStatefulSetInteractor return a new struct with statefulSetInteractor
+func NewStatefulSetInteractor(statefulSetRepo map[string]port.StatefulSetResourceGateway) StatefulSetInteractor {
+	return &statefulSetInteractor{
+		StatefulSetRepo: statefulSetRepo,
+	}
+}
+
+func (si *statefulSetInteractor) gateway(context string) (port.StatefulSetResourceGateway, error) {
+	gateway, ok := si.StatefulSetRepo[context]
+	if !ok {
+		return nil, fmt.Errorf("no gateway found for context: %s", context)
+	}
+	return gateway, nil
+}
+
+func (si *statefulSetInteractor) GetFromManyContext(ctx context.Context, namespaces []string, contexts []string) (map[string][]domain.StatefulSet, error) {
+	return getFromManyContext[domain.StatefulSet](ctx, si.StatefulSetRepo, namespaces, contexts)
+}
+
+func (si *statefulSetInteractor) GetYaml(ctx context.Context, namespace string, name string, context string) ([]byte, error) {
+	gateway, err := si.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	return gateway.GetYaml(ctx, namespace, name)
+}
+
+func (si *statefulSetInteractor) GetPods(ctx context.Context, statefulSetName, namespace, context string) ([]domain.Pod, error) {
+	gateway, err := si.gateway(context)
+	if err != nil {
+		return nil, err
+	}
+	return gateway.GetPods(ctx, statefulSetName, namespace)
+}
+
+func (si *statefulSetInteractor) Restart(ctx context.Context, statefulSetName, namespace, context string) error {
+	gateway, err := si.gateway(context)
+	if err != nil {
+		return err
+	}
+	return gateway.Restart(ctx, namespace, statefulSetName)
+}
+
+func (si *statefulSetInteractor) Scale(ctx context.Context, statefulSetName, namespace, context string, replicas int32) error {
+	if replicas < 0 {
+		return fmt.Errorf("invalid number of replicas %d", replicas)
+	}
+	gateway, err := si.gateway(context)
+	if err != nil {
+		return err
+	}
+	return gateway.Scale(ctx, namespace, statefulSetName, replicas)
+}